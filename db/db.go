@@ -5,6 +5,7 @@ package db
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"os"
@@ -58,6 +59,9 @@ type ReadOnlyDBColumnFamily struct {
 	BlockedChannels        map[string][]byte
 	FilteredStreams        map[string][]byte
 	FilteredChannels       map[string][]byte
+	ResolveCache           *ResolveCache
+	historical             historicalCache
+	AuditSignatures        bool
 	ShutdownChan           chan struct{}
 	DoneChan               chan struct{}
 	Cleanup                func()
@@ -97,6 +101,10 @@ type ResolveResult struct {
 type ResolveError struct {
 	Error     error
 	ErrorType uint8
+	// CensorHash is the hash of the channel blocking or filtering the
+	// claim, and Censor is its claim, if the error is BLOCKED.
+	CensorHash []byte
+	Censor     *ResolveResult
 }
 
 type OptionalResolveResultOrError interface {
@@ -168,9 +176,19 @@ func (res *ExpandedResolveResult) ToOutputs() ([]*pb.Output, []*pb.Output, error
 				Code: pb.Error_Code(x.ErrorType),
 			},
 		}
+		// The censoring channel is sent as an extra txo, like the channels
+		// of blocked search results.
+		if x.Censor != nil {
+			censor := x.Censor.ToOutput()
+			outputErr.Error.Blocked = &pb.Blocked{
+				Count:   1,
+				Channel: censor,
+			}
+			extraTxos = append(extraTxos, censor)
+		}
 		res := &pb.Output{Meta: outputErr}
 		txos = append(txos, res)
-		return txos, nil, nil
+		return txos, extraTxos, nil
 	}
 	if x := res.Stream.GetError(); x != nil {
 		log.Warn("Stream error: ", x)
@@ -253,15 +271,19 @@ func (res *ResolveResult) ToOutput() *pb.Output {
 		}
 	}
 
+	// Trending isn't in the db, it's filled in from es by the server.
 	claimMeta := &pb.ClaimMeta{
 		Channel:          channelOutput,
 		Repost:           repostOutput,
 		ShortUrl:         res.ShortUrl,
-		Reposted:         uint32(res.Reposted),
+		CanonicalUrl:     res.CanonicalUrl,
 		IsControlling:    res.IsControlling,
+		TakeOverHeight:   res.LastTakeoverHeight,
 		CreationHeight:   res.CreationHeight,
+		ActivationHeight: res.ActivationHeight,
 		ExpirationHeight: res.ExpirationHeight,
 		ClaimsInChannel:  res.ClaimsInChannel,
+		Reposted:         uint32(res.Reposted),
 		EffectiveAmount:  res.EffectiveAmount,
 		SupportAmount:    res.SupportAmount,
 	}
@@ -311,101 +333,6 @@ func (ps *PathSegment) String() string {
 // Iterators / db construction functions
 //
 
-func intMin(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
-func IterCF(db *grocksdb.DB, opts *IterOptions) <-chan *prefixes.PrefixRowKV {
-	ch := make(chan *prefixes.PrefixRowKV)
-
-	ro := grocksdb.NewDefaultReadOptions()
-	ro.SetFillCache(opts.FillCache)
-	it := db.NewIteratorCF(ro, opts.CfHandle)
-	opts.It = it
-
-	it.Seek(opts.Prefix)
-	if opts.Start != nil {
-		it.Seek(opts.Start)
-	}
-
-	go func() {
-		defer func() {
-			it.Close()
-			close(ch)
-			ro.Destroy()
-		}()
-
-		var prevKey []byte
-		// FIXME: There's messy uses of kv being nil / not nil here.
-		var kv *prefixes.PrefixRowKV = nil
-		if !opts.IncludeStart {
-			kv = opts.ReadRow(&prevKey)
-			it.Next()
-		}
-
-		if !it.Valid() && opts.IncludeStop && kv != nil {
-			ch <- kv
-		}
-
-		kv = &prefixes.PrefixRowKV{}
-		for ; kv != nil && !opts.StopIteration(prevKey) && it.Valid(); it.Next() {
-			if kv = opts.ReadRow(&prevKey); kv != nil {
-				ch <- kv
-			}
-		}
-	}()
-
-	return ch
-}
-
-func Iter(db *grocksdb.DB, opts *IterOptions) <-chan *prefixes.PrefixRowKV {
-	ch := make(chan *prefixes.PrefixRowKV)
-
-	ro := grocksdb.NewDefaultReadOptions()
-	/*
-		FIXME:
-		ro.SetIterateLowerBound()
-		ro.SetIterateUpperBound()
-		ro.PrefixSameAsStart() -> false
-		ro.AutoPrefixMode() -> on
-	*/
-	ro.SetFillCache(opts.FillCache)
-	it := db.NewIterator(ro)
-	opts.It = it
-
-	it.Seek(opts.Prefix)
-	if opts.Start != nil {
-		it.Seek(opts.Start)
-	}
-
-	go func() {
-		defer it.Close()
-		defer close(ch)
-
-		var prevKey []byte
-		var kv *prefixes.PrefixRowKV = &prefixes.PrefixRowKV{}
-		if !opts.IncludeStart {
-			kv = opts.ReadRow(&prevKey)
-			it.Next()
-		}
-
-		if !it.Valid() && opts.IncludeStop && kv != nil {
-			ch <- kv
-		}
-
-		for ; kv != nil && !opts.StopIteration(prevKey) && it.Valid(); it.Next() {
-			if kv = opts.ReadRow(&prevKey); kv != nil {
-				ch <- kv
-			}
-		}
-	}()
-
-	return ch
-}
-
 //
 // GetDB functions that open and return a db
 //
@@ -652,6 +579,11 @@ func (db *ReadOnlyDBColumnFamily) detectChanges(notifCh chan *internal.HeightHas
 		}
 	}
 	if rewound {
+		// The claim diffs of the disconnected blocks are gone, so we can't
+		// tell what they changed.
+		if db.ResolveCache != nil {
+			db.ResolveCache.Clear()
+		}
 		metrics.ReorgCount.Inc()
 		hash, err := db.GetBlockHash(lastHeight)
 		if err != nil {
@@ -674,10 +606,10 @@ func (db *ReadOnlyDBColumnFamily) detectChanges(notifCh chan *internal.HeightHas
 				log.Info("error getting block hash: ", err)
 				return err
 			}
+			db.invalidateResolveCache(height)
+			db.auditSignatures(height)
 			notifCh <- &internal.HeightHash{Height: uint64(height), BlockHash: hash}
 		}
-		//TODO: ClearCache
-		log.Warn("implement cache clearing")
 
 		db.LastState = state
 		metrics.BlockCount.Inc()
@@ -691,6 +623,47 @@ func (db *ReadOnlyDBColumnFamily) detectChanges(notifCh chan *internal.HeightHas
 	return nil
 }
 
+// invalidateResolveCache removes the cached resolve results that may have
+// been changed by the block at height. Those are the results including a
+// claim that was touched or deleted in the block, or the channel or reposted
+// claim of a touched claim, and the results for urls with the name of a
+// touched claim, since it may have taken over the name.
+func (db *ReadOnlyDBColumnFamily) invalidateResolveCache(height uint32) {
+	if db.ResolveCache == nil {
+		return
+	}
+	diff, err := db.GetTouchedOrDeletedClaims(height)
+	if err != nil || diff == nil {
+		log.Warnf("no claim diff at height %d, clearing resolve cache: %v", height, err)
+		db.ResolveCache.Clear()
+		return
+	}
+
+	claims := make([][]byte, 0, len(diff.TouchedClaims)+len(diff.DeletedClaims))
+	names := make([]string, 0, len(diff.TouchedClaims))
+	claims = append(claims, diff.DeletedClaims...)
+	for _, claimHash := range diff.TouchedClaims {
+		claims = append(claims, claimHash)
+		claimTxo, err := db.GetClaimTxo(claimHash)
+		if err != nil || claimTxo == nil {
+			continue
+		}
+		names = append(names, claimTxo.NormalizedName())
+		channelHash, err := db.GetChannelForClaim(claimHash, claimTxo.TxNum, claimTxo.Position)
+		if err == nil && channelHash != nil {
+			claims = append(claims, channelHash)
+		}
+		repostedClaimHash, err := db.GetRepost(claimHash)
+		if err == nil && repostedClaimHash != nil {
+			claims = append(claims, repostedClaimHash)
+		}
+	}
+
+	removed := db.ResolveCache.InvalidateClaims(claims)
+	removed += db.ResolveCache.InvalidateNames(names)
+	metrics.ResolveCacheInvalidations.Add(float64(removed))
+}
+
 func (db *ReadOnlyDBColumnFamily) ReadDBState() error {
 	state, err := db.GetDBState()
 	if err != nil {
@@ -719,16 +692,16 @@ func (db *ReadOnlyDBColumnFamily) InitHeaders() error {
 	startKeyRaw := startKey.PackKey()
 	// endKeyRaw := endKey.PackKey()
 	options := NewIterateOptions().WithPrefix([]byte{prefixes.Header}).WithCfHandle(handle)
-	options = options.WithIncludeKey(false).WithIncludeValue(true) //.WithIncludeStop(true)
-	options = options.WithStart(startKeyRaw)                       //.WithStop(endKeyRaw)
+	options = options.WithStart(startKeyRaw) //.WithStop(endKeyRaw)
 
-	ch := IterCF(db.DB, options)
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.BlockHeaderCodec, options)
+	defer it.Close()
 
-	for header := range ch {
-		db.Headers.Push(header.Value.(*prefixes.BlockHeaderValue).Header)
+	for it.Next() {
+		db.Headers.Push(it.Value().Header)
 	}
 
-	return nil
+	return it.Err()
 }
 
 // InitTxCounts initializes the txCounts map
@@ -742,12 +715,15 @@ func (db *ReadOnlyDBColumnFamily) InitTxCounts() error {
 	db.TxCounts = stack.NewSliceBacked(InitialTxCountSize)
 
 	options := NewIterateOptions().WithPrefix([]byte{prefixes.TxCount}).WithCfHandle(handle)
-	options = options.WithIncludeKey(false).WithIncludeValue(true).WithIncludeStop(true)
 
-	ch := IterCF(db.DB, options)
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.TxCountCodec, options)
+	defer it.Close()
 
-	for txCount := range ch {
-		db.TxCounts.Push(txCount.Value.(*prefixes.TxCountValue).TxCount)
+	for it.Next() {
+		db.TxCounts.Push(it.Value().TxCount)
+	}
+	if err := it.Err(); err != nil {
+		return err
 	}
 
 	duration := time.Since(start)
@@ -779,20 +755,40 @@ func (db *ReadOnlyDBColumnFamily) GetBlocksAndFilters() error {
 		return err
 	}
 
-	db.BlockedChannels = blockedChannels
-	db.BlockedStreams = blockedStreams
-
 	filteredChannels, filteredStreams, err := db.GetStreamsAndChannelRepostedByChannelHashes(db.FilteringChannelHashes)
 	if err != nil {
 		return err
 	}
 
+	// Cached resolve results include whether they're blocked or filtered.
+	if db.ResolveCache != nil && (!hashMapsEqual(db.BlockedChannels, blockedChannels) ||
+		!hashMapsEqual(db.BlockedStreams, blockedStreams) ||
+		!hashMapsEqual(db.FilteredChannels, filteredChannels) ||
+		!hashMapsEqual(db.FilteredStreams, filteredStreams)) {
+		db.ResolveCache.Clear()
+	}
+
+	db.BlockedChannels = blockedChannels
+	db.BlockedStreams = blockedStreams
 	db.FilteredChannels = filteredChannels
 	db.FilteredStreams = filteredStreams
 
 	return nil
 }
 
+// hashMapsEqual returns true if the two maps of hashes are the same.
+func hashMapsEqual(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || !bytes.Equal(v, w) {
+			return false
+		}
+	}
+	return true
+}
+
 // GetDBCF Get the database and open given column families.
 func GetDBCF(name string, cf string) (*grocksdb.DB, []*grocksdb.ColumnFamilyHandle, error) {
 	opts := grocksdb.NewDefaultOptions()
@@ -884,9 +880,8 @@ func readWriteRawNCF(db *grocksdb.DB, options *IterOptions, out string, n int, f
 		formatStr = "%s,,\n"
 	}
 
-	options.RawKey = true
-	options.RawValue = true
-	ch := IterCF(db, options)
+	it := NewIterator(context.Background(), db, options)
+	defer it.Close()
 
 	file, err := os.Create(out)
 	if err != nil {
@@ -899,13 +894,10 @@ func readWriteRawNCF(db *grocksdb.DB, options *IterOptions, out string, n int, f
 	log.Println(options.Prefix)
 	cf := string(options.Prefix)
 	file.Write([]byte(fmt.Sprintf(formatStr, options.Prefix)))
-	for kv := range ch {
+	for ; i < n && it.Next(); i++ {
 		log.Println(i)
-		if i >= n {
-			return
-		}
-		key := kv.Key.([]byte)
-		value := kv.Value.([]byte)
+		key := it.Key()
+		value := it.Value()
 		keyHex := hex.EncodeToString(key)
 		valueHex := hex.EncodeToString(value)
 		//log.Println(keyHex)
@@ -918,17 +910,15 @@ func readWriteRawNCF(db *grocksdb.DB, options *IterOptions, out string, n int, f
 		file.WriteString(",")
 		file.WriteString(valueHex)
 		file.WriteString("\n")
-
-		i++
 	}
 }
 
 // ReadWriteRawN reads n entries from a given rocksdb db and writes them as a
 // csv to a give file.
 func ReadWriteRawN(db *grocksdb.DB, options *IterOptions, out string, n int) {
-	options.RawKey = true
-	options.RawValue = true
-	ch := Iter(db, options)
+	options.CfHandle = nil
+	it := NewIterator(context.Background(), db, options)
+	defer it.Close()
 
 	file, err := os.Create(out)
 	if err != nil {
@@ -937,14 +927,10 @@ func ReadWriteRawN(db *grocksdb.DB, options *IterOptions, out string, n int) {
 	}
 	defer file.Close()
 
-	var i = 0
-	for kv := range ch {
+	for i := 0; i < n && it.Next(); i++ {
 		log.Println(i)
-		if i >= n {
-			return
-		}
-		key := kv.Key.([]byte)
-		value := kv.Value.([]byte)
+		key := it.Key()
+		value := it.Value()
 		keyHex := hex.EncodeToString(key)
 		valueHex := hex.EncodeToString(value)
 		log.Println(keyHex)
@@ -953,13 +939,17 @@ func ReadWriteRawN(db *grocksdb.DB, options *IterOptions, out string, n int) {
 		file.WriteString(",")
 		file.WriteString(valueHex)
 		file.WriteString("\n")
-
-		i++
 	}
 }
 
 // GenerateTestData generates a test data file for a prefix.
 func GenerateTestData(prefix byte, fileName string) {
+	codec, err := prefixes.GetCodec(prefix)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	log.Printf("generating %s test data", codec.Name())
+
 	dbVal, err := GetDB("/mnt/d/data/wallet/lbry-rocksdb/")
 	if err != nil {
 		log.Fatalln(err)
@@ -0,0 +1,196 @@
+package db
+
+// db_bids.go contains functions for listing the claims competing for a name.
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/lbryio/herald/db/prefixes"
+)
+
+// NameBid is an active claim for a name.
+type NameBid struct {
+	ClaimHash        []byte
+	TxNum            uint32
+	Position         uint16
+	EffectiveAmount  uint64
+	ActivationHeight uint32
+	IsControlling    bool
+}
+
+// PendingActivation is a claim or support for a name that activates at a
+// future height.
+type PendingActivation struct {
+	ClaimHash        []byte
+	TxNum            uint32
+	Position         uint16
+	Amount           uint64
+	ActivationHeight uint32
+	IsSupport        bool
+}
+
+// NameBids are the claims competing for a name.
+type NameBids struct {
+	NormalizedName string
+	// Controlling is nil if no claim controls the name.
+	Controlling *prefixes.ClaimTakeoverValue
+	// Bids are the active claims, by effective amount.
+	Bids []*NameBid
+	// Pending are the claims and supports that haven't activated yet, by
+	// activation height.
+	Pending []*PendingActivation
+}
+
+// GetNameBids returns the claims competing for a normalized name.
+func (db *ReadOnlyDBColumnFamily) GetNameBids(normalizedName string) (*NameBids, error) {
+	controlling, err := db.GetControllingClaim(normalizedName)
+	if err != nil {
+		return nil, err
+	}
+	res := &NameBids{
+		NormalizedName: normalizedName,
+		Controlling:    controlling,
+		Bids:           make([]*NameBid, 0),
+		Pending:        make([]*PendingActivation, 0),
+	}
+
+	it, err := db.EffectiveAmountNameIter(context.Background(), normalizedName)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for it.Next() {
+		key, value := it.Key(), it.Value()
+		activation, err := db.GetActivation(key.TxNum, key.Position)
+		if err != nil {
+			return nil, err
+		}
+		res.Bids = append(res.Bids, &NameBid{
+			ClaimHash:        value.ClaimHash,
+			TxNum:            key.TxNum,
+			Position:         key.Position,
+			EffectiveAmount:  key.EffectiveAmount,
+			ActivationHeight: activation,
+			IsControlling:    controlling != nil && bytes.Equal(controlling.ClaimHash, value.ClaimHash),
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	pending, err := db.pendingActivations(normalizedName)
+	if err != nil {
+		return nil, err
+	}
+	res.Pending = pending
+	return res, nil
+}
+
+// pendingActivations returns the claims and supports for a normalized name
+// activating after the current height, by activation height. The claims for
+// the name are found through their short ids, and the supports through the
+// claims.
+func (db *ReadOnlyDBColumnFamily) pendingActivations(normalizedName string) ([]*PendingActivation, error) {
+	it, err := db.ClaimShortIdIter(context.Background(), normalizedName, "")
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	res := make([]*PendingActivation, 0)
+	// Each claim has a row per length of its short id.
+	seen := make(map[prefixes.ClaimShortIDValue]bool)
+	for it.Next() {
+		value := it.Value()
+		if seen[*value] {
+			continue
+		}
+		seen[*value] = true
+		claim, err := db.GetCachedClaimHash(value.TxNum, value.Position)
+		if err != nil {
+			return nil, err
+		} else if claim == nil {
+			continue
+		}
+		res, err = db.appendPendingActivations(res, claim.ClaimHash, value.TxNum, value.Position)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	// The same order as the pending activations index.
+	sort.Slice(res, func(i, j int) bool {
+		a, b := res[i], res[j]
+		if a.ActivationHeight != b.ActivationHeight {
+			return a.ActivationHeight < b.ActivationHeight
+		}
+		if a.IsSupport != b.IsSupport {
+			return !a.IsSupport
+		}
+		if a.TxNum != b.TxNum {
+			return a.TxNum < b.TxNum
+		}
+		return a.Position < b.Position
+	})
+	return res, nil
+}
+
+// appendPendingActivations appends the claim in txNum and position, if it
+// activates after the current height, and its supports that do to res.
+func (db *ReadOnlyDBColumnFamily) appendPendingActivations(res []*PendingActivation, claimHash []byte, txNum uint32, position uint16) ([]*PendingActivation, error) {
+	activation, err := db.GetActivation(txNum, position)
+	if err != nil {
+		return nil, err
+	}
+	if activation > db.Height {
+		claimTxo, err := db.GetCachedClaimTxo(claimHash, true)
+		if err != nil {
+			return nil, err
+		} else if claimTxo != nil {
+			res = append(res, &PendingActivation{
+				ClaimHash:        claimHash,
+				TxNum:            txNum,
+				Position:         position,
+				Amount:           claimTxo.Amount,
+				ActivationHeight: activation,
+			})
+		}
+	}
+
+	handle, err := db.EnsureHandle(prefixes.ClaimToSupport)
+	if err != nil {
+		return nil, err
+	}
+	key := prefixes.NewClaimToSupportKey(claimHash, 0, 0)
+	rawKeyPrefix := prefixes.ClaimToSupportKeyPackPartial(key, 1)
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix)
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.ClaimToSupportCodec, options)
+	defer it.Close()
+	for it.Next() {
+		key := it.Key()
+		activation, err := db.GetActivationFull(key.TxNum, key.Position, true)
+		if err != nil {
+			return nil, err
+		}
+		if activation <= db.Height {
+			continue
+		}
+		res = append(res, &PendingActivation{
+			ClaimHash:        claimHash,
+			TxNum:            key.TxNum,
+			Position:         key.Position,
+			Amount:           it.Value().Amount,
+			ActivationHeight: activation,
+			IsSupport:        true,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,386 @@
+package db
+
+// db_claim.go contains functions for decoding the claims in transactions.
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/lbryio/herald/db/prefixes"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/lbryio/lbcd/claimtrie/change"
+	"github.com/lbryio/lbcd/txscript"
+	"github.com/lbryio/lbcd/wire"
+	"google.golang.org/protobuf/proto"
+)
+
+// Claim value versions, the first byte of a claim value.
+const (
+	ClaimValueUnsigned = 0
+	ClaimValueSigned   = 1
+)
+
+// ErrUndecodableClaim is returned for claim values that can't be decoded,
+// like legacy claims.
+var ErrUndecodableClaim = errors.New("claim value can't be decoded")
+
+// ClaimValue is a decoded claim, with the signature and the hash of the
+// signing channel if the claim is signed.
+type ClaimValue struct {
+	Claim       *pb.Claim
+	ChannelHash []byte
+	Signature   []byte
+	// Payload is the serialized claim, as it was signed.
+	Payload []byte
+}
+
+// DecodeClaimValue decodes the value in a claim script. Only claims using the
+// claim.proto schema are supported, not legacy ones.
+func DecodeClaimValue(value []byte) (*ClaimValue, error) {
+	if len(value) == 0 {
+		return nil, fmt.Errorf("%w: empty claim value", ErrUndecodableClaim)
+	}
+
+	res := &ClaimValue{}
+	var payload []byte
+	switch value[0] {
+	case ClaimValueUnsigned:
+		payload = value[1:]
+	case ClaimValueSigned:
+		if len(value) < 85 {
+			return nil, fmt.Errorf("%w: signed claim value too short: %d bytes", ErrUndecodableClaim, len(value))
+		}
+		// The channel hash is stored byte reversed from the claim hash.
+		res.ChannelHash = make([]byte, 20)
+		for i := 0; i < 20; i++ {
+			res.ChannelHash[i] = value[20-i]
+		}
+		res.Signature = value[21:85]
+		payload = value[85:]
+	default:
+		return nil, fmt.Errorf("%w: unsupported claim value version %d", ErrUndecodableClaim, value[0])
+	}
+
+	res.Claim = &pb.Claim{}
+	if err := proto.Unmarshal(payload, res.Claim); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUndecodableClaim, err)
+	}
+	res.Payload = payload
+	return res, nil
+}
+
+// GetClaimValue loads the tx with the given hash and decodes the claim in
+// output nout.
+func (db *ReadOnlyDBColumnFamily) GetClaimValue(txHash []byte, nout uint32) (*ClaimValue, error) {
+	_, value, err := db.getClaimScript(txHash, nout)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeClaimValue(value)
+}
+
+// GetClaimType returns the type of the claim in output nout of the tx with
+// the given hash: stream, channel, repost or collection. Legacy claims can't
+// be decoded, but they're all streams.
+func (db *ReadOnlyDBColumnFamily) GetClaimType(txHash []byte, nout uint32) (string, error) {
+	_, value, err := db.getClaimScript(txHash, nout)
+	if err != nil {
+		return "", err
+	}
+	claimValue, err := DecodeClaimValue(value)
+	if errors.Is(err, ErrUndecodableClaim) {
+		return "stream", nil
+	}
+	switch claimValue.Claim.GetType().(type) {
+	case *pb.Claim_Stream:
+		return "stream", nil
+	case *pb.Claim_Channel:
+		return "channel", nil
+	case *pb.Claim_Repost:
+		return "repost", nil
+	case *pb.Claim_Collection:
+		return "collection", nil
+	}
+	return "", nil
+}
+
+// getClaimScript loads the tx with the given hash and returns it along with
+// the value of the claim in output nout.
+func (db *ReadOnlyDBColumnFamily) getClaimScript(txHash []byte, nout uint32) (*wire.MsgTx, []byte, error) {
+	tx, err := db.getMsgTx(txHash)
+	if err != nil {
+		return nil, nil, err
+	} else if tx == nil {
+		return nil, nil, fmt.Errorf("tx %x not found", txHash)
+	}
+	if int(nout) >= len(tx.TxOut) {
+		return nil, nil, fmt.Errorf("tx %x has no output %d", txHash, nout)
+	}
+
+	script, err := txscript.ExtractClaimScript(tx.TxOut[nout].PkScript)
+	if err != nil {
+		return nil, nil, err
+	}
+	if script.Opcode == txscript.OP_SUPPORTCLAIM {
+		return nil, nil, fmt.Errorf("output %d of tx %x is a support", nout, txHash)
+	}
+	return tx, script.Value, nil
+}
+
+// getMsgTx loads and deserializes the tx with the given hash, or returns nil
+// if it isn't found.
+func (db *ReadOnlyDBColumnFamily) getMsgTx(txHash []byte) (*wire.MsgTx, error) {
+	rawTx, err := db.GetTx(txHash)
+	if err != nil || rawTx == nil {
+		return nil, err
+	}
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(rawTx)); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// GetChannelPublicKey returns the public key in the current claim of a
+// channel.
+func (db *ReadOnlyDBColumnFamily) GetChannelPublicKey(channelHash []byte) ([]byte, error) {
+	claimTxo, err := db.GetCachedClaimTxo(channelHash, true)
+	if err != nil {
+		return nil, err
+	} else if claimTxo == nil {
+		return nil, fmt.Errorf("channel %x not found", channelHash)
+	}
+	txHash, err := db.GetTxHash(claimTxo.TxNum)
+	if err != nil {
+		return nil, err
+	}
+	value, err := db.GetClaimValue(txHash, uint32(claimTxo.Position))
+	if err != nil {
+		return nil, err
+	}
+	channel := value.Claim.GetChannel()
+	if channel == nil {
+		return nil, fmt.Errorf("claim %x is not a channel", channelHash)
+	}
+	return channel.PublicKey, nil
+}
+
+// CollectionClaimHashes returns the hashes of the claims in a collection, in
+// order. They are stored byte reversed from the claim hashes, like channel
+// hashes.
+func CollectionClaimHashes(claim *pb.Claim) [][]byte {
+	refs := claim.GetCollection().GetClaimReferences()
+	claimHashes := make([][]byte, 0, len(refs))
+	for _, ref := range refs {
+		claimHash := make([]byte, len(ref.ClaimHash))
+		for i := range ref.ClaimHash {
+			claimHash[i] = ref.ClaimHash[len(ref.ClaimHash)-1-i]
+		}
+		claimHashes = append(claimHashes, claimHash)
+	}
+	return claimHashes
+}
+
+// ClaimHashFromOutPoint returns the hash of the claim created by an output.
+// Claim ids are the hash160 of the outpoint, and claim hashes are byte
+// reversed from them.
+func ClaimHashFromOutPoint(txHash []byte, nout uint32) []byte {
+	var op wire.OutPoint
+	copy(op.Hash[:], txHash)
+	op.Index = nout
+	id := change.NewClaimID(op)
+	claimHash := make([]byte, len(id))
+	for i := range id {
+		claimHash[i] = id[len(id)-1-i]
+	}
+	return claimHash
+}
+
+// claimScriptHash returns the hash of the claim created or updated by a
+// claim script in the given output, or nil for supports.
+func claimScriptHash(script *txscript.ClaimScript, txHash []byte, nout uint32) []byte {
+	switch script.Opcode {
+	case txscript.OP_CLAIMNAME:
+		return ClaimHashFromOutPoint(txHash, nout)
+	case txscript.OP_UPDATECLAIM:
+		claimHash := make([]byte, len(script.ClaimID))
+		for i := range script.ClaimID {
+			claimHash[i] = script.ClaimID[len(script.ClaimID)-1-i]
+		}
+		return claimHash
+	}
+	return nil
+}
+
+// MaxClaimHistory is the most txs GetClaimHistory follows, so a claim with a
+// broken chain of updates can't loop forever.
+const MaxClaimHistory = 10000
+
+// ClaimHistoryType is what a tx in the history of a claim did to it.
+type ClaimHistoryType int
+
+const (
+	ClaimCreated ClaimHistoryType = iota
+	ClaimUpdated
+	ClaimAbandoned
+)
+
+// ClaimHistoryEntry is a tx that created, updated or abandoned a claim, with
+// the amount and channel signature of the claim in it. For abandons Nout is
+// the input spending the claim, and there's no amount or signature.
+type ClaimHistoryEntry struct {
+	Type        ClaimHistoryType
+	TxHash      []byte
+	Nout        uint32
+	Height      uint32
+	Amount      uint64
+	ChannelHash []byte
+	Signature   []byte
+}
+
+// GetClaimHistory returns the txs that created, updated and abandoned a
+// claim, oldest first. It starts from the root txo of the claim and follows
+// each txo to the tx spending it, found in the history of the address the
+// txo pays to, up to the last txo of the claim. Abandoned claims are dropped
+// from the db, so only the ones abandoned in the blocks the undo records are
+// kept for are found, the others return nil like missing ones.
+func (db *ReadOnlyDBColumnFamily) GetClaimHistory(claimHash []byte) ([]*ClaimHistoryEntry, error) {
+	claimTxo, err := db.GetCachedClaimTxo(claimHash, true)
+	if err != nil {
+		return nil, err
+	}
+	abandoned := false
+	if claimTxo == nil {
+		claimTxo, err = db.abandonedClaimTxo(claimHash)
+		if err != nil || claimTxo == nil {
+			return nil, err
+		}
+		abandoned = true
+	}
+
+	txNum, nout := claimTxo.RootTxNum, uint32(claimTxo.RootPosition)
+	typ := ClaimCreated
+	entries := make([]*ClaimHistoryEntry, 0)
+	for len(entries) < MaxClaimHistory {
+		txHash, err := db.GetTxHash(txNum)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := db.getMsgTx(txHash)
+		if err != nil {
+			return nil, err
+		} else if tx == nil || int(nout) >= len(tx.TxOut) {
+			return nil, fmt.Errorf("txo %x:%d of claim %x not found", txHash, nout, claimHash)
+		}
+		script, err := txscript.ExtractClaimScript(tx.TxOut[nout].PkScript)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := &ClaimHistoryEntry{
+			Type:   typ,
+			TxHash: txHash,
+			Nout:   nout,
+			Amount: uint64(tx.TxOut[nout].Value),
+		}
+		entry.Height, _ = db.TxCounts.TxCountsBisectRight(txNum, txNum)
+		// Legacy claims can't be decoded, they're returned without a
+		// signature.
+		if value, err := DecodeClaimValue(script.Value); err == nil {
+			entry.ChannelHash = value.ChannelHash
+			entry.Signature = value.Signature
+		}
+		entries = append(entries, entry)
+
+		last := txNum == claimTxo.TxNum && nout == uint32(claimTxo.Position)
+		if last && !abandoned {
+			return entries, nil
+		}
+
+		spendNum, spend, input, err := db.findSpend(tx.TxOut[nout].PkScript, txHash, nout, txNum, entry.Height)
+		if err != nil {
+			return nil, err
+		} else if spend == nil {
+			return nil, fmt.Errorf("spend of txo %x:%d of claim %x not found", txHash, nout, claimHash)
+		}
+		update := -1
+		spendHash := spend.TxHash()
+		for i, out := range spend.TxOut {
+			script, err := txscript.ExtractClaimScript(out.PkScript)
+			if err == nil && script.Opcode == txscript.OP_UPDATECLAIM &&
+				bytes.Equal(claimScriptHash(script, spendHash[:], uint32(i)), claimHash) {
+				update = i
+				break
+			}
+		}
+		if update < 0 {
+			if !abandoned {
+				return nil, fmt.Errorf("txo %x:%d of claim %x is spent without an update", txHash, nout, claimHash)
+			}
+			height, _ := db.TxCounts.TxCountsBisectRight(spendNum, spendNum)
+			return append(entries, &ClaimHistoryEntry{
+				Type:   ClaimAbandoned,
+				TxHash: spendHash[:],
+				Nout:   input,
+				Height: height,
+			}), nil
+		}
+		txNum, nout, typ = spendNum, uint32(update), ClaimUpdated
+	}
+	return nil, fmt.Errorf("claim %x has more than %d txs", claimHash, MaxClaimHistory)
+}
+
+// findSpend returns the tx spending the txo in output nout of the tx with
+// the given hash and tx num, at height, with its tx num and the input
+// spending the txo. It's found in the history of the address the txo pays
+// to, so only the txs after it touching the address are read. It returns a
+// nil tx if the txo isn't spent.
+func (db *ReadOnlyDBColumnFamily) findSpend(pkScript, txHash []byte, nout, txNum, height uint32) (uint32, *wire.MsgTx, uint32, error) {
+	handle, err := db.EnsureHandle(prefixes.HashXHistory)
+	if err != nil {
+		return 0, nil, 0, err
+	}
+	key := &prefixes.HashXHistoryKey{
+		Prefix: []byte{prefixes.HashXHistory},
+		HashX:  ScriptHashX(pkScript),
+		Height: height,
+	}
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(prefixes.HashXHistoryKeyPackPartial(key, 1))
+	options = options.WithStart(prefixes.HashXHistoryKeyPackPartial(key, 2))
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.HashXHistoryCodec, options)
+	defer it.Close()
+	for it.Next() {
+		for _, spendNum := range it.Value().HashXes {
+			if spendNum <= txNum {
+				continue
+			}
+			spendHash, err := db.GetTxHash(spendNum)
+			if err != nil {
+				return 0, nil, 0, err
+			}
+			spend, err := db.getMsgTx(spendHash)
+			if err != nil {
+				return 0, nil, 0, err
+			} else if spend == nil {
+				continue
+			}
+			for i, in := range spend.TxIn {
+				if in.PreviousOutPoint.Index == nout && bytes.Equal(in.PreviousOutPoint.Hash[:], txHash) {
+					return spendNum, spend, uint32(i), nil
+				}
+			}
+		}
+	}
+	return 0, nil, 0, it.Err()
+}
+
+// ScriptHashX returns the hashX of an output script, the first 11 bytes of
+// its sha256. Claims and supports are indexed under the address they pay to,
+// without the claim script.
+func ScriptHashX(pkScript []byte) []byte {
+	hash := sha256.Sum256(txscript.StripClaimScriptPrefix(pkScript))
+	return hash[:11]
+}
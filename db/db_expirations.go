@@ -0,0 +1,123 @@
+package db
+
+// db_expirations.go contains functions for listing the claims expiring at
+// upcoming heights.
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/lbryio/herald/db/prefixes"
+)
+
+// Expiration is a claim expiring at a height.
+type Expiration struct {
+	ClaimHash        []byte
+	NormalizedName   string
+	TxNum            uint32
+	Position         uint16
+	Height           uint32
+	ExpirationHeight uint32
+}
+
+// GetExpirations returns the claims expiring from minHeight to maxHeight,
+// by expiration height, skipping the first offset and returning at most
+// limit. If channelHash is set only the claims signed by that channel are
+// returned.
+func (db *ReadOnlyDBColumnFamily) GetExpirations(minHeight, maxHeight uint32, channelHash []byte, offset, limit int) ([]*Expiration, error) {
+	if channelHash != nil {
+		return db.getChannelExpirations(minHeight, maxHeight, channelHash, offset, limit)
+	}
+
+	handle, err := db.EnsureHandle(prefixes.ClaimExpiration)
+	if err != nil {
+		return nil, err
+	}
+
+	startKey := prefixes.ClaimExpirationKeyPackPartial(prefixes.NewClaimExpirationKey(minHeight), 1)
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix([]byte{prefixes.ClaimExpiration})
+	options = options.WithStart(startKey)
+	if maxHeight < math.MaxUint32 {
+		stopKey := prefixes.ClaimExpirationKeyPackPartial(prefixes.NewClaimExpirationKey(maxHeight+1), 1)
+		options = options.WithStop(stopKey)
+	}
+
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.ClaimExpirationCodec, options)
+	defer it.Close()
+
+	res := make([]*Expiration, 0)
+	skipped := 0
+	for len(res) < limit && it.Next() {
+		key, value := it.Key(), it.Value()
+		if skipped < offset {
+			skipped++
+			continue
+		}
+		height, _ := db.TxCounts.TxCountsBisectRight(key.TxNum, key.TxNum)
+		res = append(res, &Expiration{
+			ClaimHash:        value.ClaimHash,
+			NormalizedName:   value.NormalizedName,
+			TxNum:            key.TxNum,
+			Position:         key.Position,
+			Height:           height,
+			ExpirationHeight: key.Expiration,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// getChannelExpirations returns a page of the claims signed by a channel
+// expiring from minHeight to maxHeight. The expirations are keyed by height,
+// so instead of walking every claim expiring in the range, the channel's
+// claims are walked and the ones expiring in the range are sorted the way the
+// expirations are.
+func (db *ReadOnlyDBColumnFamily) getChannelExpirations(minHeight, maxHeight uint32, channelHash []byte, offset, limit int) ([]*Expiration, error) {
+	it, err := db.ChannelClaimsIter(context.Background(), channelHash)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	res := make([]*Expiration, 0)
+	for it.Next() {
+		key, value := it.Key(), it.Value()
+		height, _ := db.TxCounts.TxCountsBisectRight(key.TxNum, key.TxNum)
+		expirationHeight := GetExpirationHeight(height)
+		if expirationHeight < minHeight || expirationHeight > maxHeight {
+			continue
+		}
+		res = append(res, &Expiration{
+			ClaimHash:        value.ClaimHash,
+			NormalizedName:   key.Name,
+			TxNum:            key.TxNum,
+			Position:         key.Position,
+			Height:           height,
+			ExpirationHeight: expirationHeight,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].ExpirationHeight != res[j].ExpirationHeight {
+			return res[i].ExpirationHeight < res[j].ExpirationHeight
+		}
+		if res[i].TxNum != res[j].TxNum {
+			return res[i].TxNum < res[j].TxNum
+		}
+		return res[i].Position < res[j].Position
+	})
+	if offset >= len(res) {
+		return res[:0], nil
+	}
+	res = res[offset:]
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
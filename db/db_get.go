@@ -3,11 +3,13 @@ package db
 // db_get.go contains the basic access functions to the database.
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"log"
 
 	"github.com/lbryio/herald/db/prefixes"
+	"github.com/lbryio/lbcd/chaincfg/chainhash"
 	"github.com/linxGnu/grocksdb"
 )
 
@@ -96,33 +98,42 @@ func (db *ReadOnlyDBColumnFamily) GetStreamsAndChannelRepostedByChannelHashes(re
 		key := prefixes.NewChannelToClaimKeyWHash(reposterChannelHash)
 		rawKeyPrefix := prefixes.ChannelToClaimKeyPackPartial(key, 1)
 		options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix)
-		options = options.WithIncludeKey(false).WithIncludeValue(true)
-		ch := IterCF(db.DB, options)
-		// for stream := range Iterate(db.DB, prefixes.ChannelToClaim, []byte{reposterChannelHash}, false) {
-		for stream := range ch {
-			value := stream.Value.(*prefixes.ChannelToClaimValue)
-			repost, err := db.GetRepost(value.ClaimHash)
+		it := NewTypedIterator(context.Background(), db.DB, prefixes.ChannelToClaimCodec, options)
+		err := db.addChannelReposts(it, reposterChannelHash, streams, channels)
+		it.Close()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return streams, channels, nil
+}
+
+// addChannelReposts adds the claims reposted by the claims of a channel to
+// the streams or channels map, depending on their names.
+func (db *ReadOnlyDBColumnFamily) addChannelReposts(it *TypedIterator[*prefixes.ChannelToClaimKey, *prefixes.ChannelToClaimValue], reposterChannelHash []byte, streams, channels map[string][]byte) error {
+	for it.Next() {
+		value := it.Value()
+		repost, err := db.GetRepost(value.ClaimHash)
+		if err != nil {
+			return err
+		}
+		if repost != nil {
+			txo, err := db.GetClaimTxo(repost)
 			if err != nil {
-				return nil, nil, err
+				return err
 			}
-			if repost != nil {
-				txo, err := db.GetClaimTxo(repost)
-				if err != nil {
-					return nil, nil, err
-				}
-				if txo != nil {
-					repostStr := hex.EncodeToString(repost)
-					if normalName := txo.NormalizedName(); len(normalName) > 0 && normalName[0] == '@' {
-						channels[repostStr] = reposterChannelHash
-					} else {
-						streams[repostStr] = reposterChannelHash
-					}
+			if txo != nil {
+				repostStr := hex.EncodeToString(repost)
+				if normalName := txo.NormalizedName(); len(normalName) > 0 && normalName[0] == '@' {
+					channels[repostStr] = reposterChannelHash
+				} else {
+					streams[repostStr] = reposterChannelHash
 				}
 			}
 		}
 	}
-
-	return streams, channels, nil
+	return it.Err()
 }
 
 // GetClaimsInChannelCount returns the number of claims in the given channel.
@@ -151,7 +162,6 @@ func (db *ReadOnlyDBColumnFamily) GetClaimsInChannelCount(channelHash []byte) (u
 }
 
 func (db *ReadOnlyDBColumnFamily) GetShortClaimIdUrl(name string, normalizedName string, claimHash []byte, rootTxNum uint32, rootPosition uint16) (string, error) {
-	prefix := []byte{prefixes.ClaimShortIdPrefix}
 	handle, err := db.EnsureHandle(prefixes.ClaimShortIdPrefix)
 	if err != nil {
 		return "", err
@@ -168,20 +178,23 @@ func (db *ReadOnlyDBColumnFamily) GetShortClaimIdUrl(name string, normalizedName
 		partialKey := prefixes.NewClaimShortIDKey(normalizedName, partialClaimId)
 		log.Printf("partialKey: %#v\n", partialKey)
 		keyPrefix := prefixes.ClaimShortIDKeyPackPartial(partialKey, 2)
-		// Prefix and handle
-		options := NewIterateOptions().WithPrefix(prefix).WithCfHandle(handle)
-		// Start and stop bounds
-		options = options.WithStart(keyPrefix).WithStop(keyPrefix)
-		// Don't include the key
-		options = options.WithIncludeValue(false)
-
-		ch := IterCF(db.DB, options)
-		row := <-ch
-		if row == nil {
+		options := NewIterateOptions().WithPrefix(keyPrefix).WithCfHandle(handle)
+
+		// Only the first claim with the partial claim id matters.
+		it := NewTypedIterator(context.Background(), db.DB, prefixes.ClaimShortIDCodec, options)
+		found := it.Next()
+		var key *prefixes.ClaimShortIDKey
+		if found {
+			key = it.Key()
+		}
+		err := it.Err()
+		it.Close()
+		if err != nil {
+			return "", err
+		} else if !found {
 			continue
 		}
 
-		key := row.Key.(*prefixes.ClaimShortIDKey)
 		if key.RootTxNum == rootTxNum && key.RootPosition == rootPosition {
 			return fmt.Sprintf("%s#%s", name, key.PartialClaimId), nil
 		}
@@ -219,21 +232,16 @@ func (db *ReadOnlyDBColumnFamily) GetRepostedCount(claimHash []byte) (int, error
 
 	key := prefixes.NewRepostedKey(claimHash)
 	keyPrefix := prefixes.RepostedKeyPackPartial(key, 1)
-	// Prefix and handle
 	options := NewIterateOptions().WithPrefix(keyPrefix).WithCfHandle(handle)
-	// Start and stop bounds
-	// options = options.WithStart(keyPrefix)
-	// Don't include the key
-	options = options.WithIncludeValue(false)
 
 	var i int = 0
-	ch := IterCF(db.DB, options)
-
-	for range ch {
+	it := NewIterator(context.Background(), db.DB, options)
+	defer it.Close()
+	for it.Next() {
 		i++
 	}
 
-	return i, nil
+	return i, it.Err()
 }
 
 func (db *ReadOnlyDBColumnFamily) GetChannelForClaim(claimHash []byte, txNum uint32, position uint16) ([]byte, error) {
@@ -273,16 +281,15 @@ func (db *ReadOnlyDBColumnFamily) GetActiveAmount(claimHash []byte, txoType uint
 	options := NewIterateOptions().WithPrefix([]byte{prefixes.ActiveAmount}).WithCfHandle(handle)
 	// Start and stop bounds
 	options = options.WithStart(startKeyRaw).WithStop(endKeyRaw)
-	// Don't include the key
-	options = options.WithIncludeKey(false).WithIncludeValue(true)
 
-	ch := IterCF(db.DB, options)
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.ActiveAmountCodec, options)
+	defer it.Close()
 	var sum uint64 = 0
-	for kv := range ch {
-		sum += kv.Value.(*prefixes.ActiveAmountValue).Amount
+	for it.Next() {
+		sum += it.Value().Amount
 	}
 
-	return sum, nil
+	return sum, it.Err()
 }
 
 func (db *ReadOnlyDBColumnFamily) GetEffectiveAmount(claimHash []byte, supportOnly bool) (uint64, error) {
@@ -348,6 +355,62 @@ func (db *ReadOnlyDBColumnFamily) GetTxHash(txNum uint32) ([]byte, error) {
 	return rawValue, nil
 }
 
+// GetTx returns the raw tx with the given hash, or nil if it isn't found.
+func (db *ReadOnlyDBColumnFamily) GetTx(txHash []byte) ([]byte, error) {
+	handle, err := db.EnsureHandle(prefixes.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := chainhash.NewHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	key := prefixes.NewTxKey(hash)
+	rawKey := key.PackKey()
+	slice, err := db.DB.GetCF(db.Opts, handle, rawKey)
+	defer slice.Free()
+	if err != nil {
+		return nil, err
+	}
+	if slice.Size() == 0 {
+		return nil, nil
+	}
+
+	rawValue := make([]byte, len(slice.Data()))
+	copy(rawValue, slice.Data())
+	return rawValue, nil
+}
+
+// GetTxNum returns the tx num of the tx with the given hash, or nil if it
+// isn't found.
+func (db *ReadOnlyDBColumnFamily) GetTxNum(txHash []byte) (*prefixes.TxNumValue, error) {
+	handle, err := db.EnsureHandle(prefixes.TxNum)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := chainhash.NewHash(txHash)
+	if err != nil {
+		return nil, err
+	}
+	key := prefixes.NewTxNumKey(hash)
+	rawKey := key.PackKey()
+	slice, err := db.DB.GetCF(db.Opts, handle, rawKey)
+	defer slice.Free()
+	if err != nil {
+		return nil, err
+	}
+	if slice.Size() == 0 {
+		return nil, nil
+	}
+
+	rawValue := make([]byte, len(slice.Data()))
+	copy(rawValue, slice.Data())
+	value := prefixes.TxNumValueUnpack(rawValue)
+	return value, nil
+}
+
 func (db *ReadOnlyDBColumnFamily) GetActivation(txNum uint32, postition uint16) (uint32, error) {
 	return db.GetActivationFull(txNum, postition, false)
 }
@@ -408,19 +471,18 @@ func (db *ReadOnlyDBColumnFamily) GetCachedClaimTxo(claim []byte, useCache bool)
 	return value, nil
 }
 
-func (db *ReadOnlyDBColumnFamily) ControllingClaimIter() <-chan *prefixes.PrefixRowKV {
+// ControllingClaimIter returns an iterator over the controlling claims of
+// all names.
+func (db *ReadOnlyDBColumnFamily) ControllingClaimIter(ctx context.Context) (*TypedIterator[*prefixes.ClaimTakeoverKey, *prefixes.ClaimTakeoverValue], error) {
 	handle, err := db.EnsureHandle(prefixes.ClaimTakeover)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	key := prefixes.NewClaimTakeoverKey("")
-	var rawKeyPrefix []byte = nil
-	rawKeyPrefix = prefixes.ClaimTakeoverKeyPackPartial(key, 0)
+	rawKeyPrefix := prefixes.ClaimTakeoverKeyPackPartial(key, 0)
 	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix)
-	options = options.WithIncludeValue(true) //.WithIncludeStop(true)
-	ch := IterCF(db.DB, options)
-	return ch
+	return NewTypedIterator(ctx, db.DB, prefixes.ClaimTakeoverCodec, options), nil
 }
 
 func (db *ReadOnlyDBColumnFamily) GetControllingClaim(name string) (*prefixes.ClaimTakeoverValue, error) {
@@ -453,6 +515,8 @@ func (db *ReadOnlyDBColumnFamily) FsGetClaimByHash(claimHash []byte) (*ResolveRe
 	claim, err := db.GetCachedClaimTxo(claimHash, true)
 	if err != nil {
 		return nil, err
+	} else if claim == nil {
+		return nil, fmt.Errorf("claim %s not found", hex.EncodeToString(claimHash))
 	}
 
 	activation, err := db.GetActivation(claim.TxNum, claim.Position)
@@ -519,25 +583,26 @@ func (db *ReadOnlyDBColumnFamily) GetDBState() (*prefixes.DBStateValue, error) {
 	return value, nil
 }
 
-func (db *ReadOnlyDBColumnFamily) EffectiveAmountNameIter(normalizedName string) <-chan *prefixes.PrefixRowKV {
+// EffectiveAmountNameIter returns an iterator over the claims for a name,
+// ordered by effective amount, highest first.
+func (db *ReadOnlyDBColumnFamily) EffectiveAmountNameIter(ctx context.Context, normalizedName string) (*TypedIterator[*prefixes.EffectiveAmountKey, *prefixes.EffectiveAmountValue], error) {
 	handle, err := db.EnsureHandle(prefixes.EffectiveAmount)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	key := prefixes.NewEffectiveAmountKey(normalizedName)
-	var rawKeyPrefix []byte = nil
-	rawKeyPrefix = prefixes.EffectiveAmountKeyPackPartial(key, 1)
+	rawKeyPrefix := prefixes.EffectiveAmountKeyPackPartial(key, 1)
 	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix)
-	options = options.WithIncludeValue(true) //.WithIncludeStop(true)
-	ch := IterCF(db.DB, options)
-	return ch
+	return NewTypedIterator(ctx, db.DB, prefixes.EffectiveAmountCodec, options), nil
 }
 
-func (db *ReadOnlyDBColumnFamily) ClaimShortIdIter(normalizedName string, claimId string) <-chan *prefixes.PrefixRowKV {
+// ClaimShortIdIter returns an iterator over the claims for a name whose
+// claim ids start with claimId, or all of them if it's empty.
+func (db *ReadOnlyDBColumnFamily) ClaimShortIdIter(ctx context.Context, normalizedName string, claimId string) (*TypedIterator[*prefixes.ClaimShortIDKey, *prefixes.ClaimShortIDValue], error) {
 	handle, err := db.EnsureHandle(prefixes.ClaimShortIdPrefix)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	key := prefixes.NewClaimShortIDKey(normalizedName, claimId)
 	var rawKeyPrefix []byte = nil
@@ -547,9 +612,7 @@ func (db *ReadOnlyDBColumnFamily) ClaimShortIdIter(normalizedName string, claimI
 		rawKeyPrefix = prefixes.ClaimShortIDKeyPackPartial(key, 1)
 	}
 	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix)
-	options = options.WithIncludeValue(true) //.WithIncludeStop(true)
-	ch := IterCF(db.DB, options)
-	return ch
+	return NewTypedIterator(ctx, db.DB, prefixes.ClaimShortIDCodec, options), nil
 }
 
 func (db *ReadOnlyDBColumnFamily) GetCachedClaimHash(txNum uint32, position uint16) (*prefixes.TXOToClaimValue, error) {
@@ -617,3 +680,77 @@ func (db *ReadOnlyDBColumnFamily) GetBlockerHash(claimHash, repostedClaimHash, c
 
 	return blockedHash, filteredHash, nil
 }
+
+// GetClaimBlockerHash looks up the repost and channel of a claim and returns
+// the hashes of the channels blocking and filtering it, if any.
+func (db *ReadOnlyDBColumnFamily) GetClaimBlockerHash(claimHash []byte) ([]byte, []byte, error) {
+	repostedClaimHash, err := db.GetRepost(claimHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	var channelHash []byte = nil
+	claimTxo, err := db.GetCachedClaimTxo(claimHash, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if claimTxo != nil {
+		channelHash, err = db.GetChannelForClaim(claimHash, claimTxo.TxNum, claimTxo.Position)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return db.GetBlockerHash(claimHash, repostedClaimHash, channelHash)
+}
+
+// ChannelClaimsIter returns an iterator over the claims signed by a channel,
+// ordered by name.
+func (db *ReadOnlyDBColumnFamily) ChannelClaimsIter(ctx context.Context, channelHash []byte) (*TypedIterator[*prefixes.ChannelToClaimKey, *prefixes.ChannelToClaimValue], error) {
+	handle, err := db.EnsureHandle(prefixes.ChannelToClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	key := prefixes.NewChannelToClaimKeyWHash(channelHash)
+	rawKeyPrefix := prefixes.ChannelToClaimKeyPackPartial(key, 1)
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix)
+	return NewTypedIterator(ctx, db.DB, prefixes.ChannelToClaimCodec, options), nil
+}
+
+// RepostedClaimsIter returns an iterator over the reposts of a claim,
+// ordered by tx num, so oldest first, or newest first in reverse.
+func (db *ReadOnlyDBColumnFamily) RepostedClaimsIter(ctx context.Context, claimHash []byte, reverse bool) (*TypedIterator[*prefixes.RepostedKey, *prefixes.RepostedValue], error) {
+	handle, err := db.EnsureHandle(prefixes.RepostedClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	key := prefixes.NewRepostedKey(claimHash)
+	rawKeyPrefix := prefixes.RepostedKeyPackPartial(key, 1)
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix).WithReverse(reverse)
+	return NewTypedIterator(ctx, db.DB, prefixes.RepostedCodec, options), nil
+}
+
+// GetTouchedOrDeletedClaims returns the claims touched and deleted in the
+// block at height, or nil if the block hasn't been written.
+func (db *ReadOnlyDBColumnFamily) GetTouchedOrDeletedClaims(height uint32) (*prefixes.TouchedOrDeletedClaimValue, error) {
+	handle, err := db.EnsureHandle(prefixes.ClaimDiff)
+	if err != nil {
+		return nil, err
+	}
+
+	key := prefixes.NewTouchedOrDeletedClaimKey(int32(height))
+	rawKey := key.PackKey()
+	slice, err := db.DB.GetCF(db.Opts, handle, rawKey)
+	defer slice.Free()
+	if err != nil {
+		return nil, err
+	}
+	if slice.Size() == 0 {
+		return nil, nil
+	}
+
+	rawValue := make([]byte, len(slice.Data()))
+	copy(rawValue, slice.Data())
+	value := prefixes.TouchedOrDeletedClaimValueUnpack(rawValue)
+	return value, nil
+}
@@ -0,0 +1,501 @@
+package db
+
+// db_history.go contains the historical view of the claim state, which
+// resolves names as they were at a past height by reverting the blocks after
+// it with their undo records.
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/lbryio/herald/db/prefixes"
+	"github.com/lbryio/herald/internal"
+	pb "github.com/lbryio/herald/protobuf/go"
+	lbryurl "github.com/lbryio/lbry.go/v3/url"
+)
+
+// ErrHeightOutOfWindow is returned for heights the undo records don't reach
+// back to, or that are past the current height.
+var ErrHeightOutOfWindow = errors.New("height is outside the supported window")
+
+// Revertable op types, the first byte of a packed op in an undo record.
+const (
+	undoOpDelete = 0
+	undoOpPut    = 1
+)
+
+// maxHistoricalStates is how many states at past heights are kept.
+const maxHistoricalStates = 16
+
+// historicalCache keeps the states AtHeight built, so each request for a
+// past height doesn't read and revert the undo records again. They're only
+// valid until the next block.
+type historicalCache struct {
+	mut    sync.Mutex
+	height uint32
+	states map[uint32]*HistoricalState
+}
+
+// revertedValue is the value of a key before the reverted blocks, or a key
+// that didn't exist then.
+type revertedValue struct {
+	value  []byte
+	exists bool
+}
+
+// HistoricalState is a read only view of the claim state at a past height.
+// The keys changed by the blocks after the height are read from their undo
+// records, everything else from the current state.
+type HistoricalState struct {
+	DB     *ReadOnlyDBColumnFamily
+	Height uint32
+
+	reverted map[string]revertedValue
+}
+
+// AtHeight returns the state at height, which has to be within the blocks
+// the undo records are kept for. States are cached until the next block.
+// Reorgs that happen while the state is used aren't detected.
+func (db *ReadOnlyDBColumnFamily) AtHeight(height uint32) (*HistoricalState, error) {
+	if height > db.Height {
+		return nil, fmt.Errorf("%w: height %d is past the current height %d", ErrHeightOutOfWindow, height, db.Height)
+	}
+
+	cache := &db.historical
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+	if cache.states == nil || cache.height != db.Height {
+		cache.height = db.Height
+		cache.states = make(map[uint32]*HistoricalState)
+	}
+	if state, ok := cache.states[height]; ok {
+		return state, nil
+	}
+
+	state, err := db.revertTo(height)
+	if err != nil {
+		return nil, err
+	}
+	if len(cache.states) >= maxHistoricalStates {
+		for h := range cache.states {
+			delete(cache.states, h)
+			break
+		}
+	}
+	cache.states[height] = state
+	return state, nil
+}
+
+// revertTo builds the state at height from the undo records of the blocks
+// after it.
+func (db *ReadOnlyDBColumnFamily) revertTo(height uint32) (*HistoricalState, error) {
+	state := &HistoricalState{
+		DB:       db,
+		Height:   height,
+		reverted: make(map[string]revertedValue),
+	}
+	for h := db.Height; h > height; h-- {
+		rawValue, exists, err := db.undoRecord(h)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, fmt.Errorf("%w: height %d is older than the undo records, the oldest supported height is %d", ErrHeightOutOfWindow, height, h)
+		}
+		if err := state.revert(rawValue); err != nil {
+			return nil, fmt.Errorf("undo record of height %d: %v", h, err)
+		}
+	}
+	return state, nil
+}
+
+// undoRecord returns the undo record of the block at height, and false if
+// it isn't kept anymore.
+func (db *ReadOnlyDBColumnFamily) undoRecord(height uint32) ([]byte, bool, error) {
+	handle, err := db.EnsureHandle(prefixes.Undo)
+	if err != nil {
+		return nil, false, err
+	}
+	key := prefixes.NewUndoKey(uint64(height))
+	slice, err := db.DB.GetCF(db.Opts, handle, key.PackKey())
+	if err != nil {
+		return nil, false, err
+	}
+	defer slice.Free()
+	if !slice.Exists() {
+		return nil, false, nil
+	}
+	rawValue := make([]byte, len(slice.Data()))
+	copy(rawValue, slice.Data())
+	return rawValue, true, nil
+}
+
+// oldestUndoHeight returns the lowest height with an undo record, and false
+// if there are none.
+func (db *ReadOnlyDBColumnFamily) oldestUndoHeight() (uint32, bool, error) {
+	handle, err := db.EnsureHandle(prefixes.Undo)
+	if err != nil {
+		return 0, false, err
+	}
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix([]byte{prefixes.Undo})
+	it := NewIterator(context.Background(), db.DB, options)
+	defer it.Close()
+	if !it.Next() {
+		return 0, false, it.Err()
+	}
+	return uint32(prefixes.UndoKeyUnpack(it.Key()).Height), true, nil
+}
+
+// abandonedClaimTxo returns the last txo of a claim abandoned in one of the
+// blocks the undo records are kept for, or nil if it wasn't. The claims
+// deleted by each block are listed in its claim diff, and the txo is read
+// back from the undo record of the block that deleted it.
+func (db *ReadOnlyDBColumnFamily) abandonedClaimTxo(claimHash []byte) (*prefixes.ClaimToTXOValue, error) {
+	oldest, ok, err := db.oldestUndoHeight()
+	if err != nil || !ok {
+		return nil, err
+	}
+	for h := db.Height; h >= oldest && h > 0; h-- {
+		diff, err := db.GetTouchedOrDeletedClaims(h)
+		if err != nil {
+			return nil, err
+		} else if diff == nil || !containsHash(diff.DeletedClaims, claimHash) {
+			continue
+		}
+		rawValue, exists, err := db.undoRecord(h)
+		if err != nil || !exists {
+			return nil, err
+		}
+		state := &HistoricalState{
+			DB:       db,
+			Height:   h - 1,
+			reverted: make(map[string]revertedValue),
+		}
+		if err := state.revert(rawValue); err != nil {
+			return nil, fmt.Errorf("undo record of height %d: %v", h, err)
+		}
+		return state.GetClaimTxo(claimHash)
+	}
+	return nil, nil
+}
+
+// containsHash returns whether hashes contains hash.
+func containsHash(hashes [][]byte, hash []byte) bool {
+	for _, h := range hashes {
+		if bytes.Equal(h, hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// revert applies the packed ops of an undo record. Each op is a byte for
+// put or delete, the big endian uint32 lengths of the key and value, the key
+// and the value.
+func (s *HistoricalState) revert(packed []byte) error {
+	for len(packed) > 0 {
+		if len(packed) < 9 {
+			return fmt.Errorf("truncated op header")
+		}
+		opType := packed[0]
+		keyLen := int(binary.BigEndian.Uint32(packed[1:]))
+		valueLen := int(binary.BigEndian.Uint32(packed[5:]))
+		packed = packed[9:]
+		if len(packed) < keyLen+valueLen {
+			return fmt.Errorf("truncated op")
+		}
+		key := packed[:keyLen]
+		value := packed[keyLen : keyLen+valueLen]
+		packed = packed[keyLen+valueLen:]
+
+		switch opType {
+		case undoOpPut:
+			s.reverted[string(key)] = revertedValue{value: value, exists: true}
+		case undoOpDelete:
+			s.reverted[string(key)] = revertedValue{}
+		default:
+			return fmt.Errorf("unknown op type %d", opType)
+		}
+	}
+	return nil
+}
+
+// get returns the value of a key at the height, or nil if it didn't exist.
+func (s *HistoricalState) get(rawKey []byte) ([]byte, error) {
+	if x, ok := s.reverted[string(rawKey)]; ok {
+		return x.value, nil
+	}
+	handle, err := s.DB.EnsureHandle(rawKey[0])
+	if err != nil {
+		return nil, err
+	}
+	slice, err := s.DB.DB.GetCF(s.DB.Opts, handle, rawKey)
+	defer slice.Free()
+	if err != nil {
+		return nil, err
+	} else if slice.Size() == 0 {
+		return nil, nil
+	}
+	rawValue := make([]byte, len(slice.Data()))
+	copy(rawValue, slice.Data())
+	return rawValue, nil
+}
+
+// prefixRows returns the keys starting with rawPrefix and their values at
+// the height, sorted by key.
+func (s *HistoricalState) prefixRows(rawPrefix []byte) ([][]byte, map[string][]byte, error) {
+	handle, err := s.DB.EnsureHandle(rawPrefix[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawPrefix)
+
+	rows := make(map[string][]byte)
+	it := NewIterator(context.Background(), s.DB.DB, options)
+	for it.Next() {
+		rows[string(it.Key())] = it.Value()
+	}
+	err = it.Err()
+	it.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	for key, x := range s.reverted {
+		if !bytes.HasPrefix([]byte(key), rawPrefix) {
+			continue
+		}
+		if x.exists {
+			rows[key] = x.value
+		} else {
+			delete(rows, key)
+		}
+	}
+
+	keys := make([][]byte, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	return keys, rows, nil
+}
+
+// GetControllingClaim returns the controlling claim of a normalized name at
+// the height, or nil.
+func (s *HistoricalState) GetControllingClaim(normalizedName string) (*prefixes.ClaimTakeoverValue, error) {
+	rawValue, err := s.get(prefixes.NewClaimTakeoverKey(normalizedName).PackKey())
+	if err != nil || rawValue == nil {
+		return nil, err
+	}
+	return prefixes.ClaimTakeoverValueUnpack(rawValue), nil
+}
+
+// GetClaimTxo returns the txo of a claim at the height, or nil.
+func (s *HistoricalState) GetClaimTxo(claimHash []byte) (*prefixes.ClaimToTXOValue, error) {
+	rawValue, err := s.get(prefixes.NewClaimToTXOKey(claimHash).PackKey())
+	if err != nil || rawValue == nil {
+		return nil, err
+	}
+	return prefixes.ClaimToTXOValueUnpack(rawValue), nil
+}
+
+// GetEffectiveAmount returns the amount of a claim and its supports that was
+// active at the height.
+func (s *HistoricalState) GetEffectiveAmount(claimHash []byte, supportOnly bool) (uint64, error) {
+	rawPrefix := prefixes.ActiveAmountKeyPackPartial(prefixes.NewActiveAmountKey(claimHash, 0, 0), 1)
+	keys, rows, err := s.prefixRows(rawPrefix)
+	if err != nil {
+		return 0, err
+	}
+	var sum uint64 = 0
+	for _, rawKey := range keys {
+		key := prefixes.ActiveAmountKeyUnpack(rawKey)
+		if key.ActivationHeight > s.Height {
+			continue
+		}
+		if supportOnly && key.TxoType != prefixes.ActivatedSupportTXOType {
+			continue
+		}
+		sum += prefixes.ActiveAmountValueUnpack(rows[string(rawKey)]).Amount
+	}
+	return sum, nil
+}
+
+// claimResult returns the claim as it was at the height, or nil if it didn't
+// exist. Short and canonical urls and claim counts aren't filled in.
+func (s *HistoricalState) claimResult(claimHash []byte) (*ResolveResult, error) {
+	claimTxo, err := s.GetClaimTxo(claimHash)
+	if err != nil || claimTxo == nil {
+		return nil, err
+	}
+	db := s.DB
+	normalizedName := claimTxo.NormalizedName()
+
+	txHash, err := db.GetTxHash(claimTxo.TxNum)
+	if err != nil {
+		return nil, err
+	}
+	height, createdHeight := db.TxCounts.TxCountsBisectRight(claimTxo.TxNum, claimTxo.RootTxNum)
+
+	var activationHeight uint32
+	rawValue, err := s.get(prefixes.NewActivationKey(prefixes.ActivateClaimTXOType, claimTxo.TxNum, claimTxo.Position).PackKey())
+	if err != nil {
+		return nil, err
+	} else if rawValue != nil {
+		activationHeight = prefixes.ActivationValueUnpack(rawValue).Height
+	}
+
+	effectiveAmount, err := s.GetEffectiveAmount(claimHash, false)
+	if err != nil {
+		return nil, err
+	}
+	supportAmount, err := s.GetEffectiveAmount(claimHash, true)
+	if err != nil {
+		return nil, err
+	}
+
+	controllingClaim, err := s.GetControllingClaim(normalizedName)
+	if err != nil {
+		return nil, err
+	}
+	var lastTakeoverHeight uint32
+	isControlling := false
+	if controllingClaim != nil {
+		lastTakeoverHeight = controllingClaim.Height
+		isControlling = bytes.Equal(controllingClaim.ClaimHash, claimHash)
+	}
+
+	var channelHash []byte
+	rawValue, err = s.get(prefixes.NewClaimToChannelKey(claimHash, claimTxo.TxNum, claimTxo.Position).PackKey())
+	if err != nil {
+		return nil, err
+	} else if rawValue != nil {
+		channelHash = prefixes.ClaimToChannelValueUnpack(rawValue).SigningHash
+	}
+
+	var repostedClaimHash []byte
+	rawValue, err = s.get(prefixes.NewRepostKey(claimHash).PackKey())
+	if err != nil {
+		return nil, err
+	} else if rawValue != nil {
+		repostedClaimHash = prefixes.RepostValueUnpack(rawValue).RepostedClaimHash
+	}
+
+	res := &ResolveResult{
+		Name:               claimTxo.Name,
+		NormalizedName:     normalizedName,
+		ClaimHash:          claimHash,
+		TxNum:              claimTxo.TxNum,
+		Position:           claimTxo.Position,
+		TxHash:             txHash,
+		Height:             height,
+		Amount:             claimTxo.Amount,
+		IsControlling:      isControlling,
+		CreationHeight:     createdHeight,
+		ActivationHeight:   activationHeight,
+		ExpirationHeight:   GetExpirationHeight(height),
+		EffectiveAmount:    effectiveAmount,
+		SupportAmount:      supportAmount,
+		LastTakeoverHeight: lastTakeoverHeight,
+		ChannelHash:        channelHash,
+		RepostedClaimHash:  repostedClaimHash,
+		SignatureValid:     claimTxo.ChannelSignatureIsValid,
+	}
+
+	if channelHash != nil {
+		channelTxo, err := s.GetClaimTxo(channelHash)
+		if err != nil {
+			return nil, err
+		}
+		if channelTxo != nil {
+			res.ChannelTxHash, err = db.GetTxHash(channelTxo.TxNum)
+			if err != nil {
+				return nil, err
+			}
+			res.ChannelTxPostition = channelTxo.Position
+			res.ChannelHeight, _ = db.TxCounts.TxCountsBisectRight(channelTxo.TxNum, channelTxo.RootTxNum)
+		}
+	}
+	if repostedClaimHash != nil {
+		repostTxo, err := s.GetClaimTxo(repostedClaimHash)
+		if err != nil {
+			return nil, err
+		}
+		if repostTxo != nil {
+			res.RepostTxHash, err = db.GetTxHash(repostTxo.TxNum)
+			if err != nil {
+				return nil, err
+			}
+			res.RepostTxPostition = repostTxo.Position
+			res.RepostHeight, _ = db.TxCounts.TxCountsBisectRight(repostTxo.TxNum, repostTxo.RootTxNum)
+		}
+	}
+	return res, nil
+}
+
+// Resolve resolves a url to the claim controlling its name at the height.
+// Only names are supported, not claim ids, amount orders or streams in
+// channels.
+func (s *HistoricalState) Resolve(url string) *ExpandedResolveResult {
+	res := NewExpandedResolveResult()
+	parsed, err := lbryurl.Parse(url, false)
+	if err != nil {
+		res.Stream = &optionalResolveResultOrError{
+			err: &ResolveError{Error: err},
+		}
+		return res
+	}
+	if parsed.StreamName != "" && parsed.ChannelName != "" ||
+		parsed.ChannelClaimId != "" || parsed.StreamClaimId != "" ||
+		parsed.PrimaryBidPosition > 0 || parsed.SecondaryBidPosition > 0 {
+		res.Stream = &optionalResolveResultOrError{
+			err: &ResolveError{Error: fmt.Errorf("Only names can be resolved at a past height, not \"%s\".", url)},
+		}
+		return res
+	}
+
+	var claim *ResolveResult
+	controllingClaim, err := s.GetControllingClaim(internal.NormalizeName(parsed.ClaimName))
+	if err == nil && controllingClaim != nil {
+		claim, err = s.claimResult(controllingClaim.ClaimHash)
+	}
+	var x OptionalResolveResultOrError
+	if err != nil {
+		x = &optionalResolveResultOrError{err: &ResolveError{Error: err}}
+	} else if claim == nil {
+		x = &optionalResolveResultOrError{
+			err: &ResolveError{
+				Error:     fmt.Errorf("Could not find claim at \"%s\" at height %d.", url, s.Height),
+				ErrorType: uint8(pb.Error_NOT_FOUND),
+			},
+		}
+	}
+	if x != nil {
+		if parsed.IsChannelUrl() {
+			res.Channel = x
+		} else {
+			res.Stream = x
+		}
+		return res
+	}
+
+	// Claims are censored by the current block and filter lists, like
+	// resolving them now would be.
+	if parsed.IsChannelUrl() {
+		return s.DB.resolveCensorAndReposts(res, url, claim, nil)
+	}
+	var channel *ResolveResult
+	if claim.ChannelHash != nil {
+		channel, err = s.claimResult(claim.ChannelHash)
+		if err != nil {
+			res.Stream = &optionalResolveResultOrError{res: claim}
+			res.Channel = &optionalResolveResultOrError{err: &ResolveError{Error: err}}
+			return res
+		}
+	}
+	return s.DB.resolveCensorAndReposts(res, url, channel, claim)
+}
@@ -4,16 +4,20 @@ package db
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/lbryio/herald/db/prefixes"
 	"github.com/lbryio/herald/internal"
+	"github.com/lbryio/herald/internal/metrics"
 	pb "github.com/lbryio/herald/protobuf/go"
 	lbryurl "github.com/lbryio/lbry.go/v3/url"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -90,7 +94,7 @@ func PrepareResolveResult(
 		}
 	}
 
-	shortUrl, err := db.GetShortClaimIdUrl(name, normalizedName, claimHash, txNum, rootPosition)
+	shortUrl, err := db.GetShortClaimIdUrl(name, normalizedName, claimHash, rootTxNum, rootPosition)
 	if err != nil {
 		return nil, err
 	}
@@ -169,13 +173,15 @@ func (db *ReadOnlyDBColumnFamily) ResolveParsedUrl(parsed *PathSegment) (*Resolv
 	normalizedName := internal.NormalizeName(parsed.name)
 	if (parsed.amountOrder == -1 && parsed.claimId == "") || parsed.amountOrder == 1 {
 		log.Warn("Resolving claim by name")
-		ch := db.ControllingClaimIter()
-		for kv := range ch {
-			key := kv.Key.(*prefixes.ClaimTakeoverKey)
-			val := kv.Value.(*prefixes.ClaimTakeoverValue)
-			log.Warnf("ClaimTakeoverKey: %#v", key)
-			log.Warnf("ClaimTakeoverValue: %#v", val)
+		it, err := db.ControllingClaimIter(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		for it.Next() {
+			log.Warnf("ClaimTakeoverKey: %#v", it.Key())
+			log.Warnf("ClaimTakeoverValue: %#v", it.Value())
 		}
+		it.Close()
 		controlling, err := db.GetControllingClaim(normalizedName)
 		log.Warnf("controlling: %#v", controlling)
 		log.Warnf("err: %#v", err)
@@ -237,14 +243,17 @@ func (db *ReadOnlyDBColumnFamily) ResolveParsedUrl(parsed *PathSegment) (*Resolv
 			j = len(parsed.claimId)
 		}
 
-		ch := db.ClaimShortIdIter(normalizedName, parsed.claimId[:j])
-		row := <-ch
-		if row == nil {
-			return nil, nil
+		it, err := db.ClaimShortIdIter(context.Background(), normalizedName, parsed.claimId[:j])
+		if err != nil {
+			return nil, err
+		}
+		defer it.Close()
+		if !it.Next() {
+			return nil, it.Err()
 		}
 
-		key := row.Key.(*prefixes.ClaimShortIDKey)
-		claimTxo := row.Value.(*prefixes.ClaimShortIDValue)
+		key := it.Key()
+		claimTxo := it.Value()
 
 		fullClaimHash, err := db.GetCachedClaimHash(claimTxo.TxNum, claimTxo.Position)
 		if err != nil {
@@ -281,15 +290,19 @@ func (db *ReadOnlyDBColumnFamily) ResolveParsedUrl(parsed *PathSegment) (*Resolv
 
 	// Resolve by amount ordering
 	log.Warn("resolving by amount ordering")
-	ch := db.EffectiveAmountNameIter(normalizedName)
+	it, err := db.EffectiveAmountNameIter(context.Background(), normalizedName)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
 	var i = 0
-	for kv := range ch {
+	for it.Next() {
 		if i+1 < amountOrder {
 			i++
 			continue
 		}
-		key := kv.Key.(*prefixes.EffectiveAmountKey)
-		claimVal := kv.Value.(*prefixes.EffectiveAmountValue)
+		key := it.Key()
+		claimVal := it.Value()
 		claimTxo, err := db.GetCachedClaimTxo(claimVal.ClaimHash, true)
 		if err != nil {
 			return nil, err
@@ -313,7 +326,7 @@ func (db *ReadOnlyDBColumnFamily) ResolveParsedUrl(parsed *PathSegment) (*Resolv
 		)
 	}
 
-	return nil, nil
+	return nil, it.Err()
 }
 
 func (db *ReadOnlyDBColumnFamily) ResolveClaimInChannel(channelHash []byte, normalizedName string) (*ResolveResult, error) {
@@ -325,14 +338,14 @@ func (db *ReadOnlyDBColumnFamily) ResolveClaimInChannel(channelHash []byte, norm
 	key := prefixes.NewChannelToClaimKey(channelHash, normalizedName)
 	rawKeyPrefix := prefixes.ChannelToClaimKeyPackPartial(key, 2)
 	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix)
-	options = options.WithIncludeValue(true) //.WithIncludeStop(true)
-	ch := IterCF(db.DB, options)
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.ChannelToClaimCodec, options)
+	defer it.Close()
 	// TODO: what's a good default size for this?
 	var candidates []*ResolveResult = make([]*ResolveResult, 0, 100)
 	var i = 0
-	for row := range ch {
-		key := row.Key.(*prefixes.ChannelToClaimKey)
-		stream := row.Value.(*prefixes.ChannelToClaimValue)
+	for it.Next() {
+		key := it.Key()
+		stream := it.Value()
 		effectiveAmount, err := db.GetEffectiveAmount(stream.ClaimHash, false)
 		if err != nil {
 			return nil, err
@@ -354,6 +367,9 @@ func (db *ReadOnlyDBColumnFamily) ResolveClaimInChannel(channelHash []byte, norm
 			break
 		}
 	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
 	log.Printf("candidates: %#v\n", candidates)
 	if len(candidates) == 0 {
 		return nil, nil
@@ -366,7 +382,72 @@ func (db *ReadOnlyDBColumnFamily) ResolveClaimInChannel(channelHash []byte, norm
 	}
 }
 
+// NormalizeUrl returns the url with its names normalized, so urls that
+// resolve to the same claims (e.g. differing only in case) are equal.
+func NormalizeUrl(url string) (string, error) {
+	normalized, _, err := parseNormalizedUrl(url)
+	return normalized, err
+}
+
+// parseNormalizedUrl returns the normalized url and the normalized names in
+// it.
+func parseNormalizedUrl(url string) (string, []string, error) {
+	parsed, err := lbryurl.Parse(url, false)
+	if err != nil {
+		return "", nil, err
+	}
+	parsed.ClaimName = internal.NormalizeName(parsed.ClaimName)
+	parsed.ContentName = internal.NormalizeName(parsed.ContentName)
+	parsed.ChannelName = internal.NormalizeName(parsed.ChannelName)
+	parsed.StreamName = internal.NormalizeName(parsed.StreamName)
+	names := make([]string, 0, 2)
+	for _, name := range []string{parsed.ClaimName, parsed.StreamName} {
+		if name != "" && (len(names) == 0 || names[0] != name) {
+			names = append(names, name)
+		}
+	}
+	return parsed.String(), names, nil
+}
+
+// Resolve resolves a url, using the resolve cache if the db has one.
 func (db *ReadOnlyDBColumnFamily) Resolve(url string) *ExpandedResolveResult {
+	if db.ResolveCache == nil {
+		return db.resolve(url)
+	}
+	key, names, err := parseNormalizedUrl(url)
+	if err != nil {
+		return db.resolve(url)
+	}
+	if res := db.ResolveCache.Get(key, time.Now()); res != nil {
+		metrics.ResolveCacheCount.With(prometheus.Labels{"result": "hit"}).Inc()
+		return res
+	}
+	metrics.ResolveCacheCount.With(prometheus.Labels{"result": "miss"}).Inc()
+
+	generation := db.ResolveCache.Generation()
+	res := db.resolve(url)
+	if isCacheable(res) {
+		db.ResolveCache.Set(key, res, names, generation, time.Now())
+	}
+	return res
+}
+
+// isCacheable returns false if resolving failed for a reason other than the
+// claim not being found or being censored, e.g. a db error.
+func isCacheable(res *ExpandedResolveResult) bool {
+	for _, x := range []OptionalResolveResultOrError{res.Stream, res.Channel} {
+		if x == nil || x.GetError() == nil {
+			continue
+		}
+		resolveErr := x.GetError()
+		if resolveErr.CensorHash == nil && resolveErr.ErrorType != uint8(pb.Error_NOT_FOUND) {
+			return false
+		}
+	}
+	return true
+}
+
+func (db *ReadOnlyDBColumnFamily) resolve(url string) *ExpandedResolveResult {
 	var res = NewExpandedResolveResult()
 
 	var channel *PathSegment = nil
@@ -494,6 +575,18 @@ func (db *ReadOnlyDBColumnFamily) Resolve(url string) *ExpandedResolveResult {
 		}
 	}
 
+	res = db.resolveCensorAndReposts(res, url, resolvedChannel, resolvedStream)
+
+	log.Warnf("leaving Resolve, parsed: %#v\n", parsed)
+	log.Warnf("leaving Resolve, res: %s\n", res)
+	return res
+}
+
+// resolveCensorAndReposts fills in res with the resolved stream or channel,
+// or a BLOCKED error if it's censored, and the claim it reposts.
+func (db *ReadOnlyDBColumnFamily) resolveCensorAndReposts(res *ExpandedResolveResult, url string, resolvedChannel, resolvedStream *ResolveResult) *ExpandedResolveResult {
+	var err error
+
 	// Getting blockers and filters
 	var repost *ResolveResult = nil
 	var repostedChannel *ResolveResult = nil
@@ -506,6 +599,7 @@ func (db *ReadOnlyDBColumnFamily) Resolve(url string) *ExpandedResolveResult {
 		var claimHash []byte = nil
 		var respostedClaimHash []byte = nil
 		var blockerHash []byte = nil
+		var filterHash []byte = nil
 		if resolvedStream != nil {
 			claim = resolvedStream
 			claimHash = resolvedStream.ClaimHash
@@ -514,7 +608,7 @@ func (db *ReadOnlyDBColumnFamily) Resolve(url string) *ExpandedResolveResult {
 			claim = resolvedChannel
 			claimHash = resolvedChannel.ClaimHash
 		}
-		blockerHash, _, err = db.GetBlockerHash(claimHash, respostedClaimHash, claim.ChannelHash)
+		blockerHash, filterHash, err = db.GetBlockerHash(claimHash, respostedClaimHash, claim.ChannelHash)
 		log.Printf("blockerHash: %s\n", hex.EncodeToString(blockerHash))
 		if err != nil {
 			res.Channel = &optionalResolveResultOrError{
@@ -522,8 +616,14 @@ func (db *ReadOnlyDBColumnFamily) Resolve(url string) *ExpandedResolveResult {
 			}
 			return res
 		}
-		if blockerHash != nil {
-			reasonRow, err := db.FsGetClaimByHash(blockerHash)
+		// Blocking takes precedence over filtering, both are reported as
+		// blocked along with the channel that censored the claim.
+		censorHash := blockerHash
+		if censorHash == nil {
+			censorHash = filterHash
+		}
+		if censorHash != nil {
+			censor, err := db.FsGetClaimByHash(censorHash)
 			if err != nil {
 				res.Channel = &optionalResolveResultOrError{
 					err: &ResolveError{Error: err},
@@ -531,7 +631,12 @@ func (db *ReadOnlyDBColumnFamily) Resolve(url string) *ExpandedResolveResult {
 				return res
 			}
 			res.Channel = &optionalResolveResultOrError{
-				err: &ResolveError{Error: fmt.Errorf("%s, %v, %v", url, blockerHash, reasonRow)},
+				err: &ResolveError{
+					Error:      fmt.Errorf("Resolve of '%s' was censored by channel with claim id '%s'.", url, hex.EncodeToString(censorHash)),
+					ErrorType:  uint8(pb.Error_BLOCKED),
+					CensorHash: censorHash,
+					Censor:     censor,
+				},
 			}
 			return res
 		}
@@ -568,7 +673,46 @@ func (db *ReadOnlyDBColumnFamily) Resolve(url string) *ExpandedResolveResult {
 		res: repostedChannel,
 	}
 
-	log.Warnf("leaving Resolve, parsed: %#v\n", parsed)
-	log.Warnf("leaving Resolve, res: %s\n", res)
 	return res
 }
+
+// ResolveClaimHash resolves a claim by its hash, with its channel, the claim
+// it reposts and whether it's censored, like resolving its url would.
+func (db *ReadOnlyDBColumnFamily) ResolveClaimHash(claimHash []byte) *ExpandedResolveResult {
+	res := NewExpandedResolveResult()
+	claimId := hex.EncodeToString(claimHash)
+	claimTxo, err := db.GetCachedClaimTxo(claimHash, true)
+	if err != nil {
+		res.Stream = &optionalResolveResultOrError{
+			err: &ResolveError{Error: err},
+		}
+		return res
+	} else if claimTxo == nil {
+		res.Stream = &optionalResolveResultOrError{
+			err: &ResolveError{
+				Error:     fmt.Errorf("Could not find claim with claim id '%s'.", claimId),
+				ErrorType: uint8(pb.Error_NOT_FOUND),
+			},
+		}
+		return res
+	}
+
+	stream, err := db.FsGetClaimByHash(claimHash)
+	if err != nil {
+		res.Stream = &optionalResolveResultOrError{
+			err: &ResolveError{Error: err},
+		}
+		return res
+	}
+	var channel *ResolveResult = nil
+	if len(stream.ChannelHash) > 0 {
+		channel, err = db.FsGetClaimByHash(stream.ChannelHash)
+		if err != nil {
+			res.Channel = &optionalResolveResultOrError{
+				err: &ResolveError{Error: err},
+			}
+			return res
+		}
+	}
+	return db.resolveCensorAndReposts(res, claimId, channel, stream)
+}
@@ -0,0 +1,191 @@
+package db
+
+// db_resolve_cache.go contains the cache of resolve results, which is
+// invalidated by claim and by name as new blocks come in.
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// resolveCacheEntry is a cached resolve result, with the claims and names it
+// depends on.
+type resolveCacheEntry struct {
+	url     string
+	res     *ExpandedResolveResult
+	claims  []string
+	names   []string
+	expires time.Time
+}
+
+// ResolveCache is an LRU cache of resolve results keyed by normalized url.
+// Entries are indexed by the claims in the result and the names in the url
+// and the result, so a block only invalidates the entries it could change.
+type ResolveCache struct {
+	Size int
+	TTL  time.Duration
+
+	mut        sync.Mutex
+	lru        *list.List
+	entries    map[string]*list.Element
+	claims     map[string]map[string]bool
+	names      map[string]map[string]bool
+	generation uint64
+}
+
+// NewResolveCache creates a cache holding up to size results for ttl.
+func NewResolveCache(size int, ttl time.Duration) *ResolveCache {
+	return &ResolveCache{
+		Size:    size,
+		TTL:     ttl,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+		claims:  make(map[string]map[string]bool),
+		names:   make(map[string]map[string]bool),
+	}
+}
+
+// Get returns the cached result for url at time now, or nil.
+func (c *ResolveCache) Get(url string, now time.Time) *ExpandedResolveResult {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	elem, ok := c.entries[url]
+	if !ok {
+		return nil
+	}
+	entry := elem.Value.(*resolveCacheEntry)
+	if now.After(entry.expires) {
+		c.remove(elem)
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	return entry.res
+}
+
+// Generation returns a counter that changes whenever entries are
+// invalidated. It's read before resolving and passed to Set, so a result
+// that was resolved while a block came in isn't cached.
+func (c *ResolveCache) Generation() uint64 {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.generation
+}
+
+// Set caches the result for url at time now, unless entries have been
+// invalidated since generation. names are the normalized names in the url.
+func (c *ResolveCache) Set(url string, res *ExpandedResolveResult, names []string, generation uint64, now time.Time) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.Size <= 0 || generation != c.generation {
+		return
+	}
+	if elem, ok := c.entries[url]; ok {
+		c.remove(elem)
+	}
+
+	entry := &resolveCacheEntry{
+		url:     url,
+		res:     res,
+		names:   names,
+		expires: now.Add(c.TTL),
+	}
+	for _, x := range []OptionalResolveResultOrError{res.Stream, res.Channel, res.Repost, res.RepostedChannel} {
+		if x == nil || x.GetResult() == nil {
+			continue
+		}
+		entry.claims = append(entry.claims, string(x.GetResult().ClaimHash))
+		entry.names = append(entry.names, x.GetResult().NormalizedName)
+	}
+	c.entries[url] = c.lru.PushFront(entry)
+	for _, claim := range entry.claims {
+		addToIndex(c.claims, claim, url)
+	}
+	for _, name := range entry.names {
+		addToIndex(c.names, name, url)
+	}
+
+	for c.lru.Len() > c.Size {
+		c.remove(c.lru.Back())
+	}
+}
+
+// InvalidateClaims removes the entries depending on any of the claims, and
+// returns the number removed.
+func (c *ResolveCache) InvalidateClaims(claimHashes [][]byte) int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	keys := make([]string, 0, len(claimHashes))
+	for _, claimHash := range claimHashes {
+		keys = append(keys, string(claimHash))
+	}
+	return c.invalidate(c.claims, keys)
+}
+
+// InvalidateNames removes the entries depending on any of the normalized
+// names, and returns the number removed.
+func (c *ResolveCache) InvalidateNames(names []string) int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.invalidate(c.names, names)
+}
+
+// Clear removes all entries.
+func (c *ResolveCache) Clear() {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.generation++
+	c.lru.Init()
+	c.entries = make(map[string]*list.Element)
+	c.claims = make(map[string]map[string]bool)
+	c.names = make(map[string]map[string]bool)
+}
+
+// Len returns the number of entries.
+func (c *ResolveCache) Len() int {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.lru.Len()
+}
+
+// invalidate removes the entries listed under keys in index, must hold the
+// lock.
+func (c *ResolveCache) invalidate(index map[string]map[string]bool, keys []string) int {
+	c.generation++
+	removed := 0
+	for _, key := range keys {
+		for url := range index[key] {
+			if elem, ok := c.entries[url]; ok {
+				c.remove(elem)
+				removed++
+			}
+		}
+	}
+	return removed
+}
+
+// remove removes an entry and its index entries, must hold the lock.
+func (c *ResolveCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*resolveCacheEntry)
+	delete(c.entries, entry.url)
+	for _, claim := range entry.claims {
+		removeFromIndex(c.claims, claim, entry.url)
+	}
+	for _, name := range entry.names {
+		removeFromIndex(c.names, name, entry.url)
+	}
+}
+
+func addToIndex(index map[string]map[string]bool, key string, url string) {
+	if index[key] == nil {
+		index[key] = make(map[string]bool)
+	}
+	index[key][url] = true
+}
+
+func removeFromIndex(index map[string]map[string]bool, key string, url string) {
+	delete(index[key], url)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
@@ -0,0 +1,165 @@
+package db
+
+// db_signature.go contains functions for verifying the channel signatures of
+// claims.
+
+import (
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/lbryio/herald/internal/metrics"
+	"github.com/lbryio/lbcd/btcec"
+	log "github.com/sirupsen/logrus"
+)
+
+// ClaimSignatureDigest returns the digest a channel signs for a claim: the
+// sha256 of the outpoint spent by the first input of the claim's tx, its tx
+// hash and little endian nout, the channel hash as it's stored in the claim,
+// which is byte reversed from the channel's claim hash, and the serialized
+// claim.
+func ClaimSignatureDigest(firstInputHash []byte, firstInputNout uint32, channelHash, payload []byte) []byte {
+	reversed := make([]byte, len(channelHash))
+	for i := range channelHash {
+		reversed[i] = channelHash[len(channelHash)-1-i]
+	}
+	h := sha256.New()
+	h.Write(firstInputHash)
+	nout := make([]byte, 4)
+	binary.LittleEndian.PutUint32(nout, firstInputNout)
+	h.Write(nout)
+	h.Write(reversed)
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+// ParseChannelPublicKey parses the public key in a channel claim, a DER
+// encoded secp256k1 SubjectPublicKeyInfo.
+func ParseChannelPublicKey(publicKey []byte) (*btcec.PublicKey, error) {
+	var info struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	rest, err := asn1.Unmarshal(publicKey, &info)
+	if err != nil {
+		return nil, err
+	} else if len(rest) > 0 {
+		return nil, fmt.Errorf("%d bytes after the public key", len(rest))
+	}
+	return btcec.ParsePubKey(info.PublicKey.Bytes, btcec.S256())
+}
+
+// VerifyClaimSignature returns whether signature, r and s concatenated, is a
+// valid ecdsa signature of digest by a channel's public key.
+func VerifyClaimSignature(publicKey, signature, digest []byte) (bool, error) {
+	if len(signature) != 64 {
+		return false, fmt.Errorf("signature is %d bytes, expected 64", len(signature))
+	}
+	key, err := ParseChannelPublicKey(publicKey)
+	if err != nil {
+		return false, err
+	}
+	sig := &btcec.Signature{
+		R: new(big.Int).SetBytes(signature[:32]),
+		S: new(big.Int).SetBytes(signature[32:]),
+	}
+	return sig.Verify(digest, key), nil
+}
+
+// SignatureCheck is the result of verifying the channel signature of a
+// claim.
+type SignatureCheck struct {
+	// ChannelHash is the channel that signed the claim, nil if the claim
+	// isn't signed.
+	ChannelHash []byte
+	Valid       bool
+	// StoredValid is the flag the writer stored for the claim.
+	StoredValid bool
+}
+
+// CheckClaimSignature verifies the channel signature of a claim against the
+// current public key of the channel. It returns nil if the claim isn't
+// found. Claims signed by a channel that doesn't exist, or isn't a channel,
+// aren't valid. Legacy claims can't be decoded, so they can't be checked.
+func (db *ReadOnlyDBColumnFamily) CheckClaimSignature(claimHash []byte) (*SignatureCheck, error) {
+	claimTxo, err := db.GetCachedClaimTxo(claimHash, true)
+	if err != nil || claimTxo == nil {
+		return nil, err
+	}
+	txHash, err := db.GetTxHash(claimTxo.TxNum)
+	if err != nil {
+		return nil, err
+	}
+	tx, value, err := db.getClaimScript(txHash, uint32(claimTxo.Position))
+	if err != nil {
+		return nil, err
+	}
+	claimValue, err := DecodeClaimValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SignatureCheck{
+		ChannelHash: claimValue.ChannelHash,
+		StoredValid: claimTxo.ChannelSignatureIsValid,
+	}
+	if claimValue.ChannelHash == nil || len(tx.TxIn) == 0 {
+		return res, nil
+	}
+	channelTxo, err := db.GetCachedClaimTxo(claimValue.ChannelHash, true)
+	if err != nil {
+		return nil, err
+	} else if channelTxo == nil {
+		return res, nil
+	}
+	channelTxHash, err := db.GetTxHash(channelTxo.TxNum)
+	if err != nil {
+		return nil, err
+	}
+	channelValue, err := db.GetClaimValue(channelTxHash, uint32(channelTxo.Position))
+	if err != nil || channelValue.Claim.GetChannel() == nil {
+		return res, nil
+	}
+
+	firstInput := tx.TxIn[0].PreviousOutPoint
+	digest := ClaimSignatureDigest(firstInput.Hash[:], firstInput.Index, claimValue.ChannelHash, claimValue.Payload)
+	res.Valid, err = VerifyClaimSignature(channelValue.Claim.GetChannel().PublicKey, claimValue.Signature, digest)
+	if err != nil {
+		// A malformed key or signature just isn't valid.
+		res.Valid = false
+	}
+	return res, nil
+}
+
+// auditSignatures verifies the signatures of the claims touched in the block
+// at height when the audit is on, and logs the ones where the stored flag
+// doesn't match.
+func (db *ReadOnlyDBColumnFamily) auditSignatures(height uint32) {
+	if !db.AuditSignatures {
+		return
+	}
+	diff, err := db.GetTouchedOrDeletedClaims(height)
+	if err != nil || diff == nil {
+		log.Warnf("no claim diff at height %d, skipping signature audit: %v", height, err)
+		return
+	}
+	for _, claimHash := range diff.TouchedClaims {
+		check, err := db.CheckClaimSignature(claimHash)
+		if err != nil {
+			log.Debugf("can't audit signature of %s: %v", hex.EncodeToString(claimHash), err)
+			continue
+		} else if check == nil || check.ChannelHash == nil {
+			continue
+		}
+		metrics.SignatureAudits.Inc()
+		if check.Valid != check.StoredValid {
+			metrics.SignatureAuditMismatches.Inc()
+			log.Warnf("signature of %s by %s at height %d: verified %v, stored %v",
+				hex.EncodeToString(claimHash), hex.EncodeToString(check.ChannelHash), height, check.Valid, check.StoredValid)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package db
+
+// db_suggest.go contains functions for suggesting claim and channel names
+// for a prefix straight from the database, used when es is disabled.
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"strings"
+
+	"github.com/lbryio/herald/db/prefixes"
+)
+
+const (
+	// MaxClaimNameLength is the longest normalized name we scan for when
+	// suggesting names.
+	MaxClaimNameLength = 255
+	// SuggestScanFactor is how many more names than requested we collect
+	// before ranking them by effective amount.
+	SuggestScanFactor = 4
+)
+
+// NameSuggestion is a name starting with a suggest prefix along with its
+// controlling claim and that claim's effective amount.
+type NameSuggestion struct {
+	NormalizedName  string
+	ClaimHash       []byte
+	EffectiveAmount uint64
+}
+
+// IsChannel returns true if the suggestion is a channel name.
+func (n *NameSuggestion) IsChannel() bool {
+	return strings.HasPrefix(n.NormalizedName, "@")
+}
+
+// SuggestNames returns up to limit names that start with the given normalized
+// prefix, ordered by the effective amount of their controlling claim, like
+// the es suggestions. Names are scanned shortest first, so for a short prefix
+// only the closest completions are ranked. Names whose controlling claim is
+// blocked or filtered are left out.
+func (db *ReadOnlyDBColumnFamily) SuggestNames(prefix string, limit int) ([]*NameSuggestion, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	handle, err := db.EnsureHandle(prefixes.ClaimTakeover)
+	if err != nil {
+		return nil, err
+	}
+	maxNames := limit * SuggestScanFactor
+	suggestions := make([]*NameSuggestion, 0, maxNames)
+
+	// Names are length prefixed in the takeover keys, so the names starting
+	// with prefix are in one range per length. A single iterator over the
+	// takeovers seeks from one range to the next.
+	nameLen := len(prefix)
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix([]byte{prefixes.ClaimTakeover})
+	options = options.WithStart(takeoverNamePrefix(prefix, nameLen))
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.ClaimTakeoverCodec, options)
+	defer it.Close()
+
+	for len(suggestions) < maxNames && it.Next() {
+		name := it.Key().NormalizedName
+		if len(name) != nameLen || !strings.HasPrefix(name, prefix) {
+			// Past the names of this length, seek to the next length that
+			// has any.
+			if len(name) > nameLen {
+				nameLen = len(name)
+			} else {
+				nameLen++
+			}
+			if nameLen > MaxClaimNameLength {
+				break
+			}
+			it.Seek(takeoverNamePrefix(prefix, nameLen))
+			continue
+		}
+
+		claimHash := it.Value().ClaimHash
+		blockedHash, filteredHash, err := db.GetClaimBlockerHash(claimHash)
+		if err != nil {
+			return nil, err
+		}
+		if blockedHash != nil || filteredHash != nil {
+			continue
+		}
+		effectiveAmount, err := db.GetEffectiveAmount(claimHash, false)
+		if err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, &NameSuggestion{
+			NormalizedName:  name,
+			ClaimHash:       claimHash,
+			EffectiveAmount: effectiveAmount,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].EffectiveAmount > suggestions[j].EffectiveAmount
+	})
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return suggestions, nil
+}
+
+// takeoverNamePrefix returns the start of the takeover keys of the names of
+// length nameLen that start with prefix.
+func takeoverNamePrefix(prefix string, nameLen int) []byte {
+	key := make([]byte, 1+2+len(prefix))
+	key[0] = prefixes.ClaimTakeover
+	binary.BigEndian.PutUint16(key[1:], uint16(nameLen))
+	copy(key[3:], []byte(prefix))
+	return key
+}
@@ -0,0 +1,120 @@
+package db
+
+// db_supports.go contains functions for listing the supports of claims.
+
+import (
+	"context"
+	"sort"
+
+	"github.com/lbryio/herald/db/prefixes"
+)
+
+// SupportOrder is the order supports are listed in.
+type SupportOrder int
+
+const (
+	SupportsByHeight SupportOrder = iota
+	SupportsByAmount
+)
+
+// Support is a support for a claim.
+type Support struct {
+	ClaimHash        []byte
+	TxNum            uint32
+	Position         uint16
+	Height           uint32
+	Amount           uint64
+	ActivationHeight uint32
+}
+
+// GetClaimSupports returns a page of limit supports for a claim starting at
+// offset, sorted by order, and the total number of supports. The first
+// support of each order is the oldest or smallest, unless descending. Only
+// the supports in the page are looked up in full.
+func (db *ReadOnlyDBColumnFamily) GetClaimSupports(claimHash []byte, order SupportOrder, descending bool, offset, limit int) ([]*Support, int, error) {
+	handle, err := db.EnsureHandle(prefixes.ClaimToSupport)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	key := prefixes.NewClaimToSupportKey(claimHash, 0, 0)
+	rawKeyPrefix := prefixes.ClaimToSupportKeyPackPartial(key, 1)
+	// The index is sorted by tx num, so by height, and the page can be
+	// picked while iterating. By amount every support has to be read.
+	byHeight := order == SupportsByHeight
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix(rawKeyPrefix)
+	options = options.WithReverse(byHeight && descending)
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.ClaimToSupportCodec, options)
+	defer it.Close()
+
+	res := make([]*Support, 0)
+	total := 0
+	for it.Next() {
+		total++
+		if byHeight && (total <= offset || total > offset+limit) {
+			continue
+		}
+		key := it.Key()
+		res = append(res, &Support{
+			ClaimHash: claimHash,
+			TxNum:     key.TxNum,
+			Position:  key.Position,
+			Amount:    it.Value().Amount,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if !byHeight {
+		sort.SliceStable(res, func(i, j int) bool {
+			return res[i].Amount < res[j].Amount
+		})
+		if descending {
+			for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+				res[i], res[j] = res[j], res[i]
+			}
+		}
+		if offset >= len(res) {
+			res = res[:0]
+		} else {
+			res = res[offset:]
+		}
+		if len(res) > limit {
+			res = res[:limit]
+		}
+	}
+
+	for _, support := range res {
+		support.Height, _ = db.TxCounts.TxCountsBisectRight(support.TxNum, support.TxNum)
+		support.ActivationHeight, err = db.GetActivationFull(support.TxNum, support.Position, true)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return res, total, nil
+}
+
+// GetSupportClaim returns the hash of the claim a support is for, or nil if
+// there's no support at the outpoint.
+func (db *ReadOnlyDBColumnFamily) GetSupportClaim(txNum uint32, position uint16) ([]byte, error) {
+	handle, err := db.EnsureHandle(prefixes.SupportToClaim)
+	if err != nil {
+		return nil, err
+	}
+
+	key := prefixes.NewSupportToClaimKey(txNum, position)
+	rawKey := key.PackKey()
+	slice, err := db.DB.GetCF(db.Opts, handle, rawKey)
+	defer slice.Free()
+	if err != nil {
+		return nil, err
+	} else if slice.Size() == 0 {
+		return nil, nil
+	}
+
+	rawValue := make([]byte, len(slice.Data()))
+	copy(rawValue, slice.Data())
+	value := prefixes.SupportToClaimValueUnpack(rawValue)
+	return value.ClaimHash, nil
+}
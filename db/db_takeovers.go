@@ -0,0 +1,217 @@
+package db
+
+// db_takeovers.go contains functions for predicting when control of names
+// will change, from the claims and supports pending activation.
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+
+	"github.com/lbryio/herald/db/prefixes"
+)
+
+// TakeoverPrediction is the takeover of a name expected from the bids
+// pending activation. New bids and abandoned claims can change it.
+type TakeoverPrediction struct {
+	NormalizedName string
+	// Controlling is the current controlling claim, nil if there's none.
+	Controlling *prefixes.ClaimTakeoverValue
+	// Height is the height of the takeover, 0 if no takeover is expected.
+	Height uint32
+	// ClaimHash is the claim expected to win the name, and EffectiveAmount
+	// its amount at the takeover.
+	ClaimHash       []byte
+	EffectiveAmount uint64
+	// NextActivationHeight is the height a bid for the name made in the
+	// next block would activate at.
+	NextActivationHeight uint32
+}
+
+// ActivationDelay returns how many blocks a claim or support for a name made
+// at height waits to activate, if the name was taken over at
+// takeoverHeight. Bids for names without a controlling claim, and supports
+// and updates of the controlling claim, activate right away.
+func ActivationDelay(height, takeoverHeight uint32) uint32 {
+	if height <= takeoverHeight {
+		return 0
+	}
+	delay := (height - takeoverHeight) / ProportionalDelayFactor
+	if delay > MaxTakeoverDelay {
+		return MaxTakeoverDelay
+	}
+	return delay
+}
+
+// takeoverBid is the amount of a claim for a name while simulating the
+// pending activations.
+type takeoverBid struct {
+	claimHash   []byte
+	txNum       uint32
+	position    uint16
+	amount      uint64
+	claimAmount uint64
+	active      bool
+}
+
+// PredictTakeover predicts the next takeover of a normalized name. The
+// pending claims and supports activate in order of height, and when an
+// active claim gets a higher amount than the controlling claim it takes
+// over, and all the bids still pending activate at once. The winner is the
+// claim with the highest amount after that.
+func (db *ReadOnlyDBColumnFamily) PredictTakeover(normalizedName string) (*TakeoverPrediction, error) {
+	controlling, err := db.GetControllingClaim(normalizedName)
+	if err != nil {
+		return nil, err
+	}
+	res := &TakeoverPrediction{
+		NormalizedName:       normalizedName,
+		Controlling:          controlling,
+		NextActivationHeight: db.Height + 1,
+	}
+	if controlling != nil {
+		res.NextActivationHeight += ActivationDelay(db.Height+1, controlling.Height)
+	}
+
+	bids := make(map[string]*takeoverBid)
+	it, err := db.EffectiveAmountNameIter(context.Background(), normalizedName)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	for it.Next() {
+		key, value := it.Key(), it.Value()
+		claimAmount, err := db.GetActiveAmount(value.ClaimHash, prefixes.ActivateClaimTXOType, db.Height+1)
+		if err != nil {
+			return nil, err
+		}
+		bids[string(value.ClaimHash)] = &takeoverBid{
+			claimHash:   value.ClaimHash,
+			txNum:       key.TxNum,
+			position:    key.Position,
+			amount:      key.EffectiveAmount,
+			claimAmount: claimAmount,
+			active:      true,
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	pending, err := db.pendingActivations(normalizedName)
+	if err != nil {
+		return nil, err
+	}
+
+	activate := func(p *PendingActivation) {
+		bid := bids[string(p.ClaimHash)]
+		if bid == nil {
+			bid = &takeoverBid{claimHash: p.ClaimHash, txNum: p.TxNum, position: p.Position}
+			bids[string(p.ClaimHash)] = bid
+		}
+		if p.IsSupport {
+			bid.amount += p.Amount
+			return
+		}
+		// An update replaces the amount of the claim, but not its supports.
+		bid.amount = bid.amount - bid.claimAmount + p.Amount
+		bid.claimAmount = p.Amount
+		if !bid.active {
+			bid.txNum, bid.position = p.TxNum, p.Position
+			bid.active = true
+		}
+	}
+	isControlling := func(bid *takeoverBid) bool {
+		return controlling != nil && bytes.Equal(bid.claimHash, controlling.ClaimHash)
+	}
+	// The controlling claim keeps the name on ties, otherwise the earlier
+	// claim wins.
+	better := func(b, c *takeoverBid) bool {
+		if b.amount != c.amount {
+			return b.amount > c.amount
+		}
+		if isControlling(b) != isControlling(c) {
+			return isControlling(b)
+		}
+		if b.txNum != c.txNum {
+			return b.txNum < c.txNum
+		}
+		return b.position < c.position
+	}
+	winner := func() *takeoverBid {
+		var best *takeoverBid
+		for _, bid := range bids {
+			if bid.active && (best == nil || better(bid, best)) {
+				best = bid
+			}
+		}
+		return best
+	}
+
+	for i := 0; i < len(pending); {
+		height := pending[i].ActivationHeight
+		for ; i < len(pending) && pending[i].ActivationHeight == height; i++ {
+			activate(pending[i])
+		}
+		best := winner()
+		if best == nil || isControlling(best) {
+			continue
+		}
+		for ; i < len(pending); i++ {
+			activate(pending[i])
+		}
+		best = winner()
+		res.Height = height
+		res.ClaimHash = best.claimHash
+		res.EffectiveAmount = best.amount
+		break
+	}
+	return res, nil
+}
+
+// PredictTakeovers predicts the takeovers of names up to maxHeight, by
+// height. Only the names with claims or supports activating by then can be
+// taken over.
+func (db *ReadOnlyDBColumnFamily) PredictTakeovers(maxHeight uint32) ([]*TakeoverPrediction, error) {
+	handle, err := db.EnsureHandle(prefixes.PendingActivation)
+	if err != nil {
+		return nil, err
+	}
+	startKey := prefixes.PendingActivationKeyPackPartial(prefixes.NewPendingActivationKey(db.Height+1), 1)
+	options := NewIterateOptions().WithCfHandle(handle).WithPrefix([]byte{prefixes.PendingActivation})
+	options = options.WithStart(startKey)
+	if maxHeight < math.MaxUint32 {
+		stopKey := prefixes.PendingActivationKeyPackPartial(prefixes.NewPendingActivationKey(maxHeight+1), 1)
+		options = options.WithStop(stopKey)
+	}
+	it := NewTypedIterator(context.Background(), db.DB, prefixes.PendingActivationCodec, options)
+	defer it.Close()
+
+	var names []string
+	seen := make(map[string]bool)
+	for it.Next() {
+		name := it.Value().NormalizedName
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	res := make([]*TakeoverPrediction, 0)
+	for _, name := range names {
+		prediction, err := db.PredictTakeover(name)
+		if err != nil {
+			return nil, err
+		}
+		if prediction.ClaimHash != nil && prediction.Height <= maxHeight {
+			res = append(res, prediction)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Height < res[j].Height
+	})
+	return res, nil
+}
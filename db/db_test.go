@@ -2,16 +2,21 @@ package db_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/csv"
 	"encoding/hex"
+	"errors"
 	"log"
 	"os"
-	"strings"
+	"reflect"
 	"testing"
+	"time"
 
 	dbpkg "github.com/lbryio/herald/db"
+	"github.com/lbryio/herald/db/dbtest"
 	"github.com/lbryio/herald/db/prefixes"
 	"github.com/lbryio/herald/internal"
+	pb "github.com/lbryio/herald/protobuf/go"
 	"github.com/linxGnu/grocksdb"
 )
 
@@ -19,100 +24,6 @@ import (
 // Utility functions for testing
 ////////////////////////////////////////////////////////////////////////////////
 
-// OpenAndFillTmpDBColumnFamlies opens a db and fills it with data from a csv file using the given column family names
-func OpenAndFillTmpDBColumnFamlies(filePath string) (*dbpkg.ReadOnlyDBColumnFamily, [][]string, func(), error) {
-
-	log.Println(filePath)
-	file, err := os.Open(filePath)
-	if err != nil {
-		log.Println(err)
-	}
-	reader := csv.NewReader(file)
-	records, err := reader.ReadAll()
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	wOpts := grocksdb.NewDefaultWriteOptions()
-	opts := grocksdb.NewDefaultOptions()
-	opts.SetCreateIfMissing(true)
-	db, err := grocksdb.OpenDb(opts, "tmp")
-	if err != nil {
-		return nil, nil, nil, err
-	}
-	var handleMap map[string]*grocksdb.ColumnFamilyHandle = make(map[string]*grocksdb.ColumnFamilyHandle)
-
-	// Make sure we always create the TxCounts column family
-	var cfNameRunes string = records[0][0]
-	txCountPrefix := string(prefixes.TxCount)
-	if !strings.Contains(cfNameRunes, txCountPrefix) {
-		cfNameRunes = cfNameRunes + txCountPrefix
-	}
-	for _, cfNameRune := range cfNameRunes {
-		cfName := string(cfNameRune)
-		log.Println(cfName)
-		handle, err := db.CreateColumnFamily(opts, cfName)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		handleMap[cfName] = handle
-	}
-	toDefer := func() {
-		db.Close()
-		err = os.RemoveAll("./tmp")
-		if err != nil {
-			log.Println(err)
-		}
-	}
-	for _, record := range records[1:] {
-		cf := record[0]
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		handle := handleMap[string(cf)]
-		key, err := hex.DecodeString(record[1])
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		val, err := hex.DecodeString(record[2])
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		db.PutCF(wOpts, handle, key, val)
-	}
-
-	myDB := &dbpkg.ReadOnlyDBColumnFamily{
-		DB:               db,
-		Handles:          handleMap,
-		Opts:             grocksdb.NewDefaultReadOptions(),
-		BlockedStreams:   make(map[string][]byte),
-		BlockedChannels:  make(map[string][]byte),
-		FilteredStreams:  make(map[string][]byte),
-		FilteredChannels: make(map[string][]byte),
-		TxCounts:         nil,
-		LastState:        nil,
-		Height:           0,
-		Headers:          nil,
-	}
-
-	// err = dbpkg.ReadDBState(myDB) //TODO: Figure out right place for this
-	// if err != nil {
-	// 	return nil, nil, nil, err
-	// }
-
-	err = myDB.InitTxCounts()
-	if err != nil {
-		return nil, nil, nil, err
-	}
-
-	// err = dbpkg.InitHeaders(myDB)
-	// if err != nil {
-	// 	return nil, nil, nil, err
-	// }
-
-	return myDB, records, toDefer, nil
-}
-
 // OpenAndFillTmpDBCF opens a db and fills it with data from a csv file
 // using the given column family handle. Old version, should probably remove.
 func OpenAndFillTmpDBCF(filePath string) (*grocksdb.DB, [][]string, func(), *grocksdb.ColumnFamilyHandle, error) {
@@ -256,11 +167,13 @@ func TestCatFullDB(t *testing.T) {
 		t.Error(err)
 		return
 	}
-	ch := db.ClaimShortIdIter("@lbry", "")
-	for row := range ch {
-		key := row.Key.(*prefixes.ClaimShortIDKey)
-		val := row.Value.(*prefixes.ClaimShortIDValue)
-		log.Printf("%#v, %#v\n", key, val)
+	it, err := db.ClaimShortIdIter(context.Background(), "@lbry", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+	for it.Next() {
+		log.Printf("%#v, %#v\n", it.Key(), it.Value())
 	}
 }
 
@@ -296,7 +209,7 @@ func TestOpenFullDB(t *testing.T) {
 func TestResolve(t *testing.T) {
 	url := "lbry://@Styxhexenhammer666#2/legacy-media-baron-les-moonves-(cbs#9"
 	filePath := "../testdata/FULL_resolve.csv"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 		return
@@ -312,10 +225,80 @@ func TestResolve(t *testing.T) {
 	}
 }
 
+// TestResolveCensored tests that blocked and filtered claims are returned as
+// BLOCKED errors with the censoring channel.
+func TestResolveCensored(t *testing.T) {
+	url := "lbry://@Styxhexenhammer666#2"
+	channelHash, _ := hex.DecodeString("2556ed1cab9d17f2a9392030a9ad7f5d138f11bd")
+	filePath := "../testdata/FULL_resolve.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+
+	for _, censored := range []map[string][]byte{db.BlockedChannels, db.FilteredChannels} {
+		censored[string(channelHash)] = channelHash
+		res := db.Resolve(url)
+		delete(censored, string(channelHash))
+
+		resolveErr := res.Channel.GetError()
+		if resolveErr == nil {
+			t.Fatalf("expected %s to be censored", url)
+		}
+		if resolveErr.ErrorType != uint8(pb.Error_BLOCKED) {
+			t.Errorf("Expected error type %d, got %d", pb.Error_BLOCKED, resolveErr.ErrorType)
+		}
+		if resolveErr.Censor == nil || !bytes.Equal(resolveErr.Censor.ClaimHash, channelHash) {
+			t.Errorf("expected the censoring channel to be %s", hex.EncodeToString(channelHash))
+		}
+		txos, extraTxos, err := res.ToOutputs()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(txos) != 1 || txos[0].GetError().GetCode() != pb.Error_BLOCKED {
+			t.Fatalf("expected a BLOCKED error, got %v", txos)
+		}
+		blocked := txos[0].GetError().GetBlocked()
+		if blocked == nil || blocked.Channel == nil || blocked.Count != 1 {
+			t.Errorf("expected the error to include the censoring channel, got %v", blocked)
+		}
+		if len(extraTxos) != 1 {
+			t.Errorf("expected the censoring channel in extra txos, got %v", extraTxos)
+		}
+	}
+
+	if res := db.Resolve(url); res.Channel.GetError() != nil {
+		t.Errorf("unexpected error: %v", res.Channel.GetError())
+	}
+}
+
+func TestNormalizeUrl(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"lbry://@Styxhexenhammer666#2/Legacy-Media#9", "lbry://@styxhexenhammer666#2/legacy-media#9"},
+		{"@styxhexenhammer666#2/legacy-media#9", "lbry://@styxhexenhammer666#2/legacy-media#9"},
+		{"lbry://StReAm$2", "lbry://stream$2"},
+	}
+	for _, tt := range tests {
+		got, err := dbpkg.NormalizeUrl(tt.url)
+		if err != nil {
+			t.Errorf("NormalizeUrl(%s): %v", tt.url, err)
+		} else if got != tt.want {
+			t.Errorf("NormalizeUrl(%s) = %s, want %s", tt.url, got, tt.want)
+		}
+	}
+	if _, err := dbpkg.NormalizeUrl("lbry://@"); err == nil {
+		t.Errorf("expected an error for an invalid url")
+	}
+}
+
 func TestGetDBState(t *testing.T) {
 	filePath := "../testdata/s_resolve.csv"
 	want := uint32(1072108)
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -336,7 +319,7 @@ func TestGetRepostedClaim(t *testing.T) {
 	// Should be non-existent
 	channelHash2, _ := hex.DecodeString("2556ed1cab9d17f2a9392030a9ad7f5d138f11bf")
 	filePath := "../testdata/W_resolve.csv"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -373,7 +356,7 @@ func TestGetRepost(t *testing.T) {
 	channelHash2, _ := hex.DecodeString("000009ca6e0caaaef16872b4bd4f6f1b8c2363e2")
 	filePath := "../testdata/V_resolve.csv"
 	// want := uint32(3670)
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -407,7 +390,7 @@ func TestGetClaimsInChannelCount(t *testing.T) {
 	channelHash, _ := hex.DecodeString("2556ed1cab9d17f2a9392030a9ad7f5d138f11bd")
 	filePath := "../testdata/Z_resolve.csv"
 	want := uint32(3670)
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -436,7 +419,7 @@ func TestGetShortClaimIdUrl(t *testing.T) {
 	var position uint16 = 0
 	filePath := "../testdata/F_resolve.csv"
 	log.Println(filePath)
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -454,16 +437,20 @@ func TestClaimShortIdIter(t *testing.T) {
 	filePath := "../testdata/F_cat.csv"
 	normalName := "cat"
 	claimId := "0"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
 	defer toDefer()
 
-	ch := db.ClaimShortIdIter(normalName, claimId)
+	it, err := db.ClaimShortIdIter(context.Background(), normalName, claimId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
 
-	for row := range ch {
-		key := row.Key.(*prefixes.ClaimShortIDKey)
+	for it.Next() {
+		key := it.Key()
 		log.Println(key)
 		if key.NormalizedName != normalName {
 			t.Errorf("Expected %s, got %s", normalName, key.NormalizedName)
@@ -471,6 +458,61 @@ func TestClaimShortIdIter(t *testing.T) {
 	}
 }
 
+// TestSuggestNames tests suggesting names for a prefix from the controlling
+// claims of the names.
+func TestSuggestNames(t *testing.T) {
+	filePath := "../testdata/D_suggest.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	defer toDefer()
+	db.Height = 10
+
+	type suggestion struct {
+		name   string
+		amount uint64
+		hash   string
+	}
+	check := func(prefix string, want []suggestion) {
+		t.Helper()
+		suggestions, err := db.SuggestNames(prefix, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(suggestions) != len(want) {
+			t.Fatalf("Expected %d suggestions for %s, got %d", len(want), prefix, len(suggestions))
+		}
+		for i, w := range want {
+			got := suggestions[i]
+			if got.NormalizedName != w.name || got.EffectiveAmount != w.amount || hex.EncodeToString(got.ClaimHash) != w.hash {
+				t.Errorf("Expected %v, got %v", w, got)
+			}
+		}
+	}
+
+	check("cat", []suggestion{
+		{"catalog", 1000, "dddddddddddddddddddddddddddddddddddddddd"},
+		{"cat", 500, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		// the top bid for cats isn't controlling, the controlling claim is
+		// suggested
+		{"cats", 100, "9999999999999999999999999999999999999999"},
+	})
+	check("@c", []suggestion{
+		{"@cat", 700, "eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"},
+	})
+	check("catalogs", nil)
+
+	// A name whose controlling claim is blocked isn't suggested.
+	blockedHash, _ := hex.DecodeString("dddddddddddddddddddddddddddddddddddddddd")
+	db.BlockedStreams[string(blockedHash)] = []byte("blocker")
+	check("cat", []suggestion{
+		{"cat", 500, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		{"cats", 100, "9999999999999999999999999999999999999999"},
+	})
+}
+
 // TestPrintTXOToCLaim Utility function to cat the TXOToClaim csv.
 func TestPrintTXOToClaim(t *testing.T) {
 	filePath := "../testdata/G_2.csv"
@@ -484,7 +526,7 @@ func TestGetTXOToClaim(t *testing.T) {
 	var txNum uint32 = 1456296
 	var position uint16 = 0
 	filePath := "../testdata/G_2.csv"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -512,7 +554,7 @@ func TestGetClaimToChannel(t *testing.T) {
 	var val []byte = nil
 
 	filePath := "../testdata/I_resolve.csv"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -541,7 +583,7 @@ func TestGetEffectiveAmount(t *testing.T) {
 	want := uint64(586370959900)
 	claimHashStr := "2556ed1cab9d17f2a9392030a9ad7f5d138f11bd"
 	claimHash, _ := hex.DecodeString(claimHashStr)
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -566,7 +608,7 @@ func TestGetSupportAmount(t *testing.T) {
 		t.Error(err)
 	}
 	filePath := "../testdata/a_resolve.csv"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -586,7 +628,7 @@ func TestGetTxHash(t *testing.T) {
 	want := "54e14ff0c404c29b3d39ae4d249435f167d5cd4ce5a428ecb745b3df1c8e3dde"
 
 	filePath := "../testdata/X_resolve.csv"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -628,7 +670,7 @@ func TestGetActivation(t *testing.T) {
 	txNum := uint32(0x6284e3)
 	position := uint16(0x0)
 	want := uint32(0xa6b65)
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 	}
@@ -659,7 +701,7 @@ func TestGetClaimToTXO(t *testing.T) {
 		return
 	}
 	filePath := "../testdata/E_resolve.csv"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 		return
@@ -688,7 +730,7 @@ func TestGetControllingClaim(t *testing.T) {
 	claimName := internal.NormalizeName("@Styxhexenhammer666")
 	claimHash := "2556ed1cab9d17f2a9392030a9ad7f5d138f11bd"
 	filePath := "../testdata/P_resolve.csv"
-	db, _, toDefer, err := OpenAndFillTmpDBColumnFamlies(filePath)
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
 	if err != nil {
 		t.Error(err)
 		return
@@ -720,18 +762,17 @@ func TestIter(t *testing.T) {
 	records = records[1:]
 	defer toDefer()
 	// test prefix
-	options := dbpkg.NewIterateOptions().WithPrefix([]byte{prefixes.RepostedClaim}).WithIncludeValue(true)
+	options := dbpkg.NewIterateOptions().WithPrefix([]byte{prefixes.RepostedClaim})
 	options = options.WithCfHandle(handle)
-	// ch := dbpkg.Iter(db, options)
-	ch := dbpkg.IterCF(db, options)
+	it := dbpkg.NewTypedIterator(context.Background(), db, prefixes.RepostedCodec, options)
+	defer it.Close()
 	var i = 0
-	for kv := range ch {
-		// log.Println(kv.Key)
-		gotKey := kv.Key.(*prefixes.RepostedKey).PackKey()
+	for it.Next() {
+		gotKey := it.Key().PackKey()
 
-		keyPartial3 := prefixes.RepostedKeyPackPartial(kv.Key.(*prefixes.RepostedKey), 3)
-		keyPartial2 := prefixes.RepostedKeyPackPartial(kv.Key.(*prefixes.RepostedKey), 2)
-		keyPartial1 := prefixes.RepostedKeyPackPartial(kv.Key.(*prefixes.RepostedKey), 1)
+		keyPartial3 := prefixes.RepostedKeyPackPartial(it.Key(), 3)
+		keyPartial2 := prefixes.RepostedKeyPackPartial(it.Key(), 2)
+		keyPartial1 := prefixes.RepostedKeyPackPartial(it.Key(), 1)
 
 		// Check pack partial for sanity
 		if !bytes.HasPrefix(gotKey, keyPartial3) {
@@ -744,7 +785,7 @@ func TestIter(t *testing.T) {
 			t.Errorf("%+v should be prefix of %+v\n", keyPartial1, gotKey)
 		}
 
-		got := kv.Value.(*prefixes.RepostedValue).PackValue()
+		got := it.Value().PackValue()
 		wantKey, err := hex.DecodeString(records[i][0])
 		if err != nil {
 			log.Println(err)
@@ -761,6 +802,9 @@ func TestIter(t *testing.T) {
 		}
 		i++
 	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
 
 	// Test start / stop
 	start, err := hex.DecodeString(records[0][0])
@@ -771,12 +815,13 @@ func TestIter(t *testing.T) {
 	if err != nil {
 		log.Println(err)
 	}
-	options2 := dbpkg.NewIterateOptions().WithStart(start).WithStop(stop).WithIncludeValue(true)
+	options2 := dbpkg.NewIterateOptions().WithStart(start).WithStop(stop)
 	options2 = options2.WithCfHandle(handle)
-	ch2 := dbpkg.IterCF(db, options2)
+	it2 := dbpkg.NewTypedIterator(context.Background(), db, prefixes.RepostedCodec, options2)
+	defer it2.Close()
 	i = 0
-	for kv := range ch2 {
-		got := kv.Value.(*prefixes.RepostedValue).PackValue()
+	for it2.Next() {
+		got := it2.Value().PackValue()
 		want, err := hex.DecodeString(records[i][1])
 		if err != nil {
 			log.Println(err)
@@ -786,4 +831,992 @@ func TestIter(t *testing.T) {
 		}
 		i++
 	}
+	if err := it2.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestIterator tests the pull based iterator, its bounds, closing it early and
+// cancelling it.
+func TestIterator(t *testing.T) {
+	filePath := "../testdata/W.csv"
+	db, records, toDefer, handle, err := OpenAndFillTmpDBCF(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// skip the cf
+	records = records[1:]
+	defer toDefer()
+	rawKey := func(i int) []byte {
+		key, err := hex.DecodeString(records[i][0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+	count := func(options *dbpkg.IterOptions) int {
+		it := dbpkg.NewIterator(context.Background(), db, options.WithCfHandle(handle))
+		defer it.Close()
+		var n = 0
+		for it.Next() {
+			n++
+		}
+		if it.Err() != nil {
+			t.Error(it.Err())
+		}
+		return n
+	}
+
+	it := dbpkg.NewTypedIterator(context.Background(), db, prefixes.RepostedCodec,
+		dbpkg.NewIterateOptions().WithPrefix([]byte{prefixes.RepostedClaim}).WithCfHandle(handle))
+	var i = 0
+	for it.Next() {
+		if !bytes.Equal(it.Key().PackKey(), rawKey(i)) || hex.EncodeToString(it.Value().PackValue()) != records[i][1] {
+			t.Errorf("Expected row %d to be %v, got %x", i, records[i], it.RawKey())
+		}
+		i++
+	}
+	if i != len(records) {
+		t.Errorf("Expected %d rows, got %d", len(records), i)
+	}
+	// Closing twice and reading after closing are fine.
+	it.Close()
+	it.Close()
+	if it.Next() {
+		t.Error("Expected no rows after closing")
+	}
+
+	tests := []struct {
+		name    string
+		options *dbpkg.IterOptions
+		want    int
+	}{
+		{"start and stop", dbpkg.NewIterateOptions().WithStart(rawKey(2)).WithStop(rawKey(5)), 3},
+		{"include stop", dbpkg.NewIterateOptions().WithStart(rawKey(2)).WithStop(rawKey(5)).WithIncludeStop(true), 4},
+		{"exclude start", dbpkg.NewIterateOptions().WithStart(rawKey(2)).WithStop(rawKey(5)).WithIncludeStart(false), 2},
+		{"start before prefix", dbpkg.NewIterateOptions().WithPrefix([]byte{prefixes.RepostedClaim}).WithStart([]byte{0}), len(records)},
+		{"other prefix", dbpkg.NewIterateOptions().WithPrefix([]byte{prefixes.Repost}), 0},
+	}
+	for _, tt := range tests {
+		if got := count(tt.options); got != tt.want {
+			t.Errorf("%s: expected %d rows, got %d", tt.name, tt.want, got)
+		}
+	}
+
+	// Seeking moves to the row at or after the key, and not out of the range.
+	seekIt := dbpkg.NewIterator(context.Background(), db, dbpkg.NewIterateOptions().WithStart(rawKey(2)).WithCfHandle(handle))
+	defer seekIt.Close()
+	seekIt.Seek(rawKey(5))
+	if !seekIt.Next() || !bytes.Equal(seekIt.Key(), rawKey(5)) {
+		t.Errorf("Expected row 5 after seeking to it, got %x", seekIt.Key())
+	}
+	seekIt.Seek(rawKey(0))
+	if !seekIt.Next() || !bytes.Equal(seekIt.Key(), rawKey(2)) {
+		t.Errorf("Expected row 2 after seeking before the start, got %x", seekIt.Key())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it2 := dbpkg.NewIterator(ctx, db, dbpkg.NewIterateOptions().WithCfHandle(handle))
+	defer it2.Close()
+	if !it2.Next() {
+		t.Fatal("Expected a row")
+	}
+	cancel()
+	if it2.Next() || !errors.Is(it2.Err(), context.Canceled) {
+		t.Errorf("Expected the iterator to stop when cancelled, got %v", it2.Err())
+	}
+}
+
+// TestIteratorReverse tests that iterating in reverse returns the same rows
+// as iterating forward, in reverse order, for all the combinations of
+// bounds.
+func TestIteratorReverse(t *testing.T) {
+	filePath := "../testdata/W.csv"
+	db, records, toDefer, handle, err := OpenAndFillTmpDBCF(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// skip the cf
+	records = records[1:]
+	defer toDefer()
+	rawKey := func(i int) []byte {
+		key, err := hex.DecodeString(records[i][0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+	// rows returns the indices of the records iterated over.
+	rows := func(options *dbpkg.IterOptions) []int {
+		it := dbpkg.NewIterator(context.Background(), db, options.WithCfHandle(handle))
+		defer it.Close()
+		var res []int
+		for it.Next() {
+			var found = false
+			for i := range records {
+				if hex.EncodeToString(it.Key()) == records[i][0] {
+					if hex.EncodeToString(it.Value()) != records[i][1] {
+						t.Errorf("Expected value %s for row %d, got %x", records[i][1], i, it.Value())
+					}
+					res = append(res, i)
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Unexpected key %x", it.Key())
+			}
+		}
+		if it.Err() != nil {
+			t.Error(it.Err())
+		}
+		return res
+	}
+
+	tests := []struct {
+		name    string
+		options func() *dbpkg.IterOptions
+		want    []int
+	}{
+		{
+			name: "all",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions()
+			},
+			want: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			name: "prefix",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithPrefix([]byte{prefixes.RepostedClaim})
+			},
+			want: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			name: "partial key prefix",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithPrefix(rawKey(1)[:21])
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "other prefix",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithPrefix([]byte{prefixes.Repost})
+			},
+			want: nil,
+		},
+		{
+			name: "start and stop",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStart(rawKey(2)).WithStop(rawKey(5))
+			},
+			want: []int{2, 3, 4},
+		},
+		{
+			name: "include stop",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStart(rawKey(2)).WithStop(rawKey(5)).WithIncludeStop(true)
+			},
+			want: []int{2, 3, 4, 5},
+		},
+		{
+			name: "exclude start",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStart(rawKey(2)).WithStop(rawKey(5)).WithIncludeStart(false)
+			},
+			want: []int{3, 4},
+		},
+		{
+			name: "exclude start include stop",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStart(rawKey(2)).WithStop(rawKey(5)).WithIncludeStart(false).WithIncludeStop(true)
+			},
+			want: []int{3, 4, 5},
+		},
+		{
+			name: "start only",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStart(rawKey(7))
+			},
+			want: []int{7, 8, 9},
+		},
+		{
+			name: "stop only",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStop(rawKey(2))
+			},
+			want: []int{0, 1},
+		},
+		{
+			name: "include last key",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStart(rawKey(8)).WithStop(rawKey(9)).WithIncludeStop(true)
+			},
+			want: []int{8, 9},
+		},
+		{
+			name: "partial keys",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStart(rawKey(1)[:21]).WithStop(rawKey(3)[:21]).WithIncludeStart(false).WithIncludeStop(true)
+			},
+			want: []int{3},
+		},
+		{
+			name: "prefix and stop",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithPrefix(rawKey(1)[:21]).WithStop(rawKey(2))
+			},
+			want: []int{1},
+		},
+		{
+			name: "empty range",
+			options: func() *dbpkg.IterOptions {
+				return dbpkg.NewIterateOptions().WithStart(rawKey(5)).WithStop(rawKey(5))
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forward := rows(tt.options())
+			if !reflect.DeepEqual(forward, tt.want) {
+				t.Errorf("Expected rows %v, got %v", tt.want, forward)
+			}
+			var want []int
+			for i := len(tt.want) - 1; i >= 0; i-- {
+				want = append(want, tt.want[i])
+			}
+			reverse := rows(tt.options().WithReverse(true))
+			if !reflect.DeepEqual(reverse, want) {
+				t.Errorf("Expected rows %v in reverse, got %v", want, reverse)
+			}
+		})
+	}
+}
+
+func TestGetTouchedOrDeletedClaims(t *testing.T) {
+	filePath := "../testdata/Y_resolve.csv"
+	want, _ := hex.DecodeString("045c39bf4b974ba7f8e0ba89a2f97fcfede52c33")
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+	diff, err := db.GetTouchedOrDeletedClaims(0x105b24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil {
+		t.Fatal("expected a claim diff")
+	}
+	if len(diff.TouchedClaims) != 80 || len(diff.DeletedClaims) != 0 {
+		t.Errorf("expected 80 touched and 0 deleted claims, got %d and %d", len(diff.TouchedClaims), len(diff.DeletedClaims))
+	}
+	if !bytes.Equal(diff.TouchedClaims[0], want) {
+		t.Errorf("Expected %s, got %s", hex.EncodeToString(want), hex.EncodeToString(diff.TouchedClaims[0]))
+	}
+	diff, err = db.GetTouchedOrDeletedClaims(1)
+	if err != nil {
+		t.Error(err)
+	}
+	if diff != nil {
+		t.Errorf("expected no claim diff, got %s", diff)
+	}
+}
+
+// testResolveResult is a resolved claim for resolve cache tests.
+type testResolveResult struct {
+	res *dbpkg.ResolveResult
+}
+
+func (x *testResolveResult) GetResult() *dbpkg.ResolveResult { return x.res }
+func (x *testResolveResult) GetError() *dbpkg.ResolveError   { return nil }
+func (x *testResolveResult) String() string                  { return x.res.String() }
+
+// resolveCacheResult makes a resolve result for a stream in a channel.
+func resolveCacheResult(streamHash, channelHash []byte, streamName, channelName string) *dbpkg.ExpandedResolveResult {
+	res := dbpkg.NewExpandedResolveResult()
+	res.Stream = &testResolveResult{&dbpkg.ResolveResult{ClaimHash: streamHash, NormalizedName: streamName}}
+	res.Channel = &testResolveResult{&dbpkg.ResolveResult{ClaimHash: channelHash, NormalizedName: channelName}}
+	return res
+}
+
+func TestResolveCache(t *testing.T) {
+	now := time.Now()
+	cache := dbpkg.NewResolveCache(2, time.Minute)
+	a := resolveCacheResult([]byte("stream-a"), []byte("channel"), "a", "@channel")
+	b := resolveCacheResult([]byte("stream-b"), []byte("channel"), "b", "@channel")
+	c := resolveCacheResult([]byte("stream-c"), []byte("other"), "c", "@other")
+
+	cache.Set("lbry://@channel/a", a, []string{"@channel", "a"}, cache.Generation(), now)
+	cache.Set("lbry://@channel/b", b, []string{"@channel", "b"}, cache.Generation(), now)
+	if cache.Get("lbry://@channel/a", now) != a {
+		t.Errorf("expected a to be cached")
+	}
+	// b is the least recently used, so it's evicted.
+	cache.Set("lbry://@other/c", c, []string{"@other", "c"}, cache.Generation(), now)
+	if cache.Get("lbry://@channel/b", now) != nil {
+		t.Errorf("expected b to be evicted")
+	}
+	if cache.Get("lbry://@channel/a", now.Add(time.Minute*2)) != nil {
+		t.Errorf("expected a to be expired")
+	}
+	if cache.Len() != 1 {
+		t.Errorf("expected 1 entry, got %d", cache.Len())
+	}
+
+	cache.Set("lbry://@channel/a", a, []string{"@channel", "a"}, cache.Generation(), now)
+	if n := cache.InvalidateClaims([][]byte{[]byte("unrelated")}); n != 0 {
+		t.Errorf("expected no entries to be invalidated, got %d", n)
+	}
+	if n := cache.InvalidateClaims([][]byte{[]byte("channel")}); n != 1 {
+		t.Errorf("expected 1 entry to be invalidated, got %d", n)
+	}
+	if cache.Get("lbry://@channel/a", now) != nil || cache.Get("lbry://@other/c", now) != c {
+		t.Errorf("expected only a to be invalidated")
+	}
+
+	// A name in the url invalidates it even if it wasn't found.
+	notFound := dbpkg.NewExpandedResolveResult()
+	cache.Set("lbry://d", notFound, []string{"d"}, cache.Generation(), now)
+	if n := cache.InvalidateNames([]string{"d"}); n != 1 {
+		t.Errorf("expected 1 entry to be invalidated, got %d", n)
+	}
+
+	// Results resolved while entries were invalidated aren't cached.
+	generation := cache.Generation()
+	cache.InvalidateNames([]string{"e"})
+	cache.Set("lbry://@channel/b", b, []string{"@channel", "b"}, generation, now)
+	if cache.Get("lbry://@channel/b", now) != nil {
+		t.Errorf("expected b not to be cached")
+	}
+
+	cache.Clear()
+	if cache.Len() != 0 {
+		t.Errorf("expected an empty cache, got %d entries", cache.Len())
+	}
+}
+
+func TestGetClaimValue(t *testing.T) {
+	filePath := "../testdata/B_claim.csv"
+	txHash, _ := hex.DecodeString("a2da17a6866bd9efe926678d0d8b70956dafc327561190711f63cb043f9cf209")
+	channelHash, _ := hex.DecodeString("2556ed1cab9d17f2a9392030a9ad7f5d138f11bd")
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+	value, err := db.GetClaimValue(txHash, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Claim.GetStream() == nil {
+		t.Errorf("expected a stream claim")
+	}
+	if value.Claim.Title != "Herald test stream" {
+		t.Errorf("Expected title %q, got %q", "Herald test stream", value.Claim.Title)
+	}
+	if !bytes.Equal(value.ChannelHash, channelHash) {
+		t.Errorf("Expected channel %s, got %s", hex.EncodeToString(channelHash), hex.EncodeToString(value.ChannelHash))
+	}
+	if !bytes.Equal(value.Signature, bytes.Repeat([]byte{0xab}, 64)) {
+		t.Errorf("unexpected signature %s", hex.EncodeToString(value.Signature))
+	}
+	if _, err := db.GetClaimValue(txHash, 0); err == nil {
+		t.Errorf("expected an error for an output without a claim")
+	}
+	if _, err := db.GetClaimValue(txHash, 2); err == nil {
+		t.Errorf("expected an error for a missing output")
+	}
+}
+
+func TestDecodeClaimValue(t *testing.T) {
+	if value, err := dbpkg.DecodeClaimValue([]byte{0, 0x42, 4, 't', 'e', 's', 't'}); err != nil {
+		t.Error(err)
+	} else if value.Claim.Title != "test" || value.ChannelHash != nil || value.Signature != nil {
+		t.Errorf("unexpected unsigned claim %v", value)
+	}
+	// Legacy claims aren't supported.
+	if _, err := dbpkg.DecodeClaimValue([]byte{0x08, 0x01}); !errors.Is(err, dbpkg.ErrUndecodableClaim) {
+		t.Errorf("expected an error for a legacy claim, got %v", err)
+	}
+	if _, err := dbpkg.DecodeClaimValue([]byte{1, 2, 3}); !errors.Is(err, dbpkg.ErrUndecodableClaim) {
+		t.Errorf("expected an error for a short signed claim")
+	}
+}
+
+func TestResolveAtHeight(t *testing.T) {
+	filePath := "../testdata/M_history.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+	db.Height = 10
+	claimA := bytes.Repeat([]byte{0xaa}, 20)
+	claimB := bytes.Repeat([]byte{0xbb}, 20)
+	channel := bytes.Repeat([]byte{0xcc}, 20)
+
+	tests := []struct {
+		name            string
+		height          uint32
+		want            []byte
+		takeoverHeight  uint32
+		effectiveAmount uint64
+		supportAmount   uint64
+	}{
+		{"current", 10, claimB, 10, 300000000, 0},
+		{"before takeover", 9, claimA, 4, 250000000, 50000000},
+		{"before support", 8, claimA, 4, 200000000, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, err := db.AtHeight(tt.height)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res := state.Resolve("lbry://test")
+			if res.Stream.GetError() != nil {
+				t.Fatal(res.Stream.GetError())
+			}
+			stream := res.Stream.GetResult()
+			if !bytes.Equal(stream.ClaimHash, tt.want) {
+				t.Errorf("Expected %x, got %x", tt.want, stream.ClaimHash)
+			}
+			if !stream.IsControlling || stream.LastTakeoverHeight != tt.takeoverHeight {
+				t.Errorf("Expected controlling since %d, got %v since %d", tt.takeoverHeight, stream.IsControlling, stream.LastTakeoverHeight)
+			}
+			if stream.EffectiveAmount != tt.effectiveAmount || stream.SupportAmount != tt.supportAmount {
+				t.Errorf("Expected amounts %d/%d, got %d/%d", tt.effectiveAmount, tt.supportAmount, stream.EffectiveAmount, stream.SupportAmount)
+			}
+			if bytes.Equal(tt.want, claimA) {
+				channelTxHash := make([]byte, 32)
+				channelTxHash[0] = 3
+				if !bytes.Equal(stream.ChannelHash, channel) || !bytes.Equal(stream.ChannelTxHash, channelTxHash) {
+					t.Errorf("Expected channel %x in tx %x, got %x in tx %x", channel, channelTxHash, stream.ChannelHash, stream.ChannelTxHash)
+				}
+				if res.Channel.GetResult() == nil || !bytes.Equal(res.Channel.GetResult().ClaimHash, channel) {
+					t.Errorf("Expected channel result, got %s", res.Channel)
+				}
+			}
+		})
+	}
+
+	state, err := db.AtHeight(9)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := state.Resolve("lbry://@chan")
+	if res.Channel.GetResult() == nil || !bytes.Equal(res.Channel.GetResult().ClaimHash, channel) {
+		t.Errorf("Expected channel %x, got %s", channel, res.Channel)
+	}
+	res = state.Resolve("lbry://missing")
+	if x := res.Stream.GetError(); x == nil || x.ErrorType != uint8(pb.Error_NOT_FOUND) {
+		t.Errorf("Expected not found, got %s", res.Stream)
+	}
+	res = state.Resolve("lbry://test#aa")
+	if res.Stream.GetError() == nil {
+		t.Errorf("Expected an error resolving a claim id at a past height, got %s", res.Stream)
+	}
+
+	// Blocking applies at past heights too.
+	db.BlockedStreams[string(claimA)] = channel
+	res = state.Resolve("lbry://test")
+	if x := res.Channel.GetError(); x == nil || x.ErrorType != uint8(pb.Error_BLOCKED) || !bytes.Equal(x.CensorHash, channel) {
+		t.Errorf("Expected the claim to be blocked by %x, got %s", channel, res.Channel)
+	}
+	delete(db.BlockedStreams, string(claimA))
+
+	// States are cached until the height changes.
+	if again, err := db.AtHeight(9); err != nil || again != state {
+		t.Errorf("Expected the cached state, got %p and %v", again, err)
+	}
+	db.Height = 9
+	if again, err := db.AtHeight(9); err != nil || again == state {
+		t.Errorf("Expected a new state after the height changed, got %p and %v", again, err)
+	}
+	db.Height = 10
+
+	for _, height := range []uint32{7, 11} {
+		if _, err := db.AtHeight(height); !errors.Is(err, dbpkg.ErrHeightOutOfWindow) {
+			t.Errorf("Expected height %d to be out of the window, got %v", height, err)
+		}
+	}
+}
+
+func TestCollectionClaimHashes(t *testing.T) {
+	claim := &pb.Claim{
+		Type: &pb.Claim_Collection{Collection: &pb.ClaimList{ClaimReferences: []*pb.ClaimReference{
+			{ClaimHash: []byte{1, 2, 3}},
+			{ClaimHash: []byte{4, 5}},
+		}}},
+	}
+	got := dbpkg.CollectionClaimHashes(claim)
+	want := [][]byte{{3, 2, 1}, {5, 4}}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d claim hashes, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if !bytes.Equal(got[i], want[i]) {
+			t.Errorf("Expected %x, got %x", want[i], got[i])
+		}
+	}
+	if got := dbpkg.CollectionClaimHashes(&pb.Claim{}); len(got) != 0 {
+		t.Errorf("Expected no claim hashes for a claim that isn't a collection, got %x", got)
+	}
+}
+
+func TestGetClaimHistory(t *testing.T) {
+	filePath := "../testdata/B_claim_history.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+	if err := db.InitTxCounts(); err != nil {
+		t.Fatal(err)
+	}
+	db.Height = 6
+
+	// The claim history was created at height 1, then updated and signed by
+	// the tx at height 3 at output 1, moving it to another address, and
+	// updated again at height 4. The claim gone was created at height 5 and
+	// abandoned by the first input of the tx at height 6. The tx at height
+	// 2 pays to the address of history without spending it.
+	channelHash := bytes.Repeat([]byte{0xcc}, 20)
+	type entry struct {
+		typ     dbpkg.ClaimHistoryType
+		txHash  string
+		nout    uint32
+		height  uint32
+		amount  uint64
+		channel []byte
+	}
+	tests := []struct {
+		name      string
+		claimHash string
+		want      []entry
+	}{
+		{"updated", "df01d1f2e91cc18f43916b7e9097c2baa4e69ddb", []entry{
+			{dbpkg.ClaimCreated, "4d6ad309b3dab8eba6708486a4ee219a6bfa1771fd99d5f9e9435379684035c4", 0, 1, 100000000, nil},
+			{dbpkg.ClaimUpdated, "666d1b1912f6f0f37eb9fe1ff4c08fbdf9eb67a94dc1b087966f899a5faca1f4", 1, 3, 200000000, channelHash},
+			{dbpkg.ClaimUpdated, "08d6ebcfa02ee52e51aa08cf4b57de83b23213e11a12655c998c3e317ee52434", 0, 4, 300000000, nil},
+		}},
+		{"abandoned", "9dd0150f15687912b0c64702bb750ac94ad50f1e", []entry{
+			{dbpkg.ClaimCreated, "0477dc40035ff398463e3db8a5ed7fa69faf520667e9c676d3d1df4f32ab0552", 0, 5, 100000000, nil},
+			{dbpkg.ClaimAbandoned, "372fff361483b2581c278e5eb22c2b0c79a6d10654db35256304f54622f73526", 0, 6, 0, nil},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claimHash, _ := hex.DecodeString(tt.claimHash)
+			entries, err := db.GetClaimHistory(claimHash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(entries) != len(tt.want) {
+				t.Fatalf("Expected %d entries, got %d", len(tt.want), len(entries))
+			}
+			for i, w := range tt.want {
+				got := entries[i]
+				if got.Type != w.typ || hex.EncodeToString(got.TxHash) != w.txHash || got.Nout != w.nout {
+					t.Errorf("Expected %d at %s:%d, got %d at %x:%d", w.typ, w.txHash, w.nout, got.Type, got.TxHash, got.Nout)
+				}
+				if got.Height != w.height || got.Amount != w.amount {
+					t.Errorf("Expected height %d and amount %d, got %d and %d", w.height, w.amount, got.Height, got.Amount)
+				}
+				if !bytes.Equal(got.ChannelHash, w.channel) || (w.channel != nil) != (got.Signature != nil) {
+					t.Errorf("Expected channel %x, got %x with signature %x", w.channel, got.ChannelHash, got.Signature)
+				}
+			}
+		})
+	}
+
+	entries, err := db.GetClaimHistory(bytes.Repeat([]byte{0xff}, 20))
+	if err != nil || entries != nil {
+		t.Errorf("Expected no entries for a missing claim, got %v, %v", entries, err)
+	}
+}
+
+func TestGetNameBids(t *testing.T) {
+	filePath := "../testdata/Q_name_bids.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+	db.Height = 10
+
+	bids, err := db.GetNameBids("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	aa, bb := bytes.Repeat([]byte{0xaa}, 20), bytes.Repeat([]byte{0xbb}, 20)
+	if bids.Controlling == nil || !bytes.Equal(bids.Controlling.ClaimHash, aa) {
+		t.Errorf("Expected the controlling claim to be %x, got %v", aa, bids.Controlling)
+	}
+	wantBids := []struct {
+		claimHash   []byte
+		amount      uint64
+		activation  uint32
+		controlling bool
+	}{
+		{aa, 100, 4, true},
+		{bb, 50, 6, false},
+	}
+	if len(bids.Bids) != len(wantBids) {
+		t.Fatalf("Expected %d bids, got %d", len(wantBids), len(bids.Bids))
+	}
+	for i, w := range wantBids {
+		got := bids.Bids[i]
+		if !bytes.Equal(got.ClaimHash, w.claimHash) || got.EffectiveAmount != w.amount {
+			t.Errorf("Expected bid %x for %d, got %x for %d", w.claimHash, w.amount, got.ClaimHash, got.EffectiveAmount)
+		}
+		if got.ActivationHeight != w.activation || got.IsControlling != w.controlling {
+			t.Errorf("Expected activation %d and controlling %v, got %d and %v", w.activation, w.controlling, got.ActivationHeight, got.IsControlling)
+		}
+	}
+
+	// The activation at the current height and the one for another name
+	// are left out.
+	wantPending := []struct {
+		claimHash []byte
+		amount    uint64
+		height    uint32
+		isSupport bool
+	}{
+		{bytes.Repeat([]byte{0xcc}, 20), 200, 12, false},
+		{aa, 30, 13, true},
+	}
+	if len(bids.Pending) != len(wantPending) {
+		t.Fatalf("Expected %d pending activations, got %d", len(wantPending), len(bids.Pending))
+	}
+	for i, w := range wantPending {
+		got := bids.Pending[i]
+		if !bytes.Equal(got.ClaimHash, w.claimHash) || got.Amount != w.amount {
+			t.Errorf("Expected pending %x for %d, got %x for %d", w.claimHash, w.amount, got.ClaimHash, got.Amount)
+		}
+		if got.ActivationHeight != w.height || got.IsSupport != w.isSupport {
+			t.Errorf("Expected height %d and support %v, got %d and %v", w.height, w.isSupport, got.ActivationHeight, got.IsSupport)
+		}
+	}
+}
+
+func TestGetClaimSupports(t *testing.T) {
+	filePath := "../testdata/K_supports.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+	if err := db.InitTxCounts(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The supports are in txs 4, 6 and 9, which are a block before their
+	// tx num in the test data and activate two blocks later.
+	claimHash := bytes.Repeat([]byte{0xaa}, 20)
+	tests := []struct {
+		name       string
+		order      dbpkg.SupportOrder
+		descending bool
+		want       []uint32
+	}{
+		{"height", dbpkg.SupportsByHeight, false, []uint32{4, 6, 9}},
+		{"height descending", dbpkg.SupportsByHeight, true, []uint32{9, 6, 4}},
+		{"amount", dbpkg.SupportsByAmount, false, []uint32{4, 9, 6}},
+		{"amount descending", dbpkg.SupportsByAmount, true, []uint32{6, 9, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			supports, total, err := db.GetClaimSupports(claimHash, tt.order, tt.descending, 0, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != len(tt.want) {
+				t.Errorf("Expected a total of %d, got %d", len(tt.want), total)
+			}
+			if len(supports) != len(tt.want) {
+				t.Fatalf("Expected %d supports, got %d", len(tt.want), len(supports))
+			}
+			for i, txNum := range tt.want {
+				got := supports[i]
+				if got.TxNum != txNum || got.Height != txNum-1 || got.ActivationHeight != txNum+1 {
+					t.Errorf("Expected support %d in tx %d, got %+v", i, txNum, got)
+				}
+			}
+		})
+	}
+
+	// Pages are picked in the order asked for.
+	for _, tt := range tests {
+		supports, total, err := db.GetClaimSupports(claimHash, tt.order, tt.descending, 1, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if total != 3 || len(supports) != 1 || supports[0].TxNum != tt.want[1] || supports[0].ActivationHeight != tt.want[1]+1 {
+			t.Errorf("%s: Expected the support in tx %d of 3, got %v of %d", tt.name, tt.want[1], supports, total)
+		}
+	}
+	supports, total, err := db.GetClaimSupports(claimHash, dbpkg.SupportsByAmount, false, 3, 10)
+	if err != nil || total != 3 || len(supports) != 0 {
+		t.Errorf("Expected no supports past the end of 3, got %v of %d, %v", supports, total, err)
+	}
+
+	got, err := db.GetSupportClaim(6, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, claimHash) {
+		t.Errorf("Expected support claim %x, got %x", claimHash, got)
+	}
+	got, err = db.GetSupportClaim(6, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != nil {
+		t.Errorf("Expected no claim, got %x", got)
+	}
+}
+
+func TestGetClaimType(t *testing.T) {
+	filePath := "../testdata/J_channel_claims.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+
+	// The claims in the test data are in txs 2 to 6, the one in tx 4 is a
+	// legacy claim.
+	want := []string{"stream", "repost", "stream", "stream", "collection"}
+	for i, claimType := range want {
+		txHash, err := db.GetTxHash(uint32(i + 2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := db.GetClaimType(txHash, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != claimType {
+			t.Errorf("Expected the claim in tx %d to be a %s, got %q", i+2, claimType, got)
+		}
+	}
+}
+
+func TestRepostedClaimsIter(t *testing.T) {
+	filePath := "../testdata/W_reposts.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+
+	// The reposts in the test data are in txs 2 to 4, and their claim
+	// hashes are their tx num repeated.
+	for _, reverse := range []bool{false, true} {
+		it, err := db.RepostedClaimsIter(context.Background(), bytes.Repeat([]byte{0xee}, 20), reverse)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []uint32
+		for it.Next() {
+			key, value := it.Key(), it.Value()
+			if !bytes.Equal(value.ClaimHash, bytes.Repeat([]byte{byte(key.TxNum)}, 20)) {
+				t.Errorf("Expected the repost in tx %d, got %x", key.TxNum, value.ClaimHash)
+			}
+			got = append(got, key.TxNum)
+		}
+		it.Close()
+		want := []uint32{2, 3, 4}
+		if reverse {
+			want = []uint32{4, 3, 2}
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected reposts in txs %v, got %v", want, got)
+		}
+	}
+}
+
+func TestGetExpirations(t *testing.T) {
+	filePath := "../testdata/O_expirations.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+	if err := db.InitTxCounts(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The claims in the test data are in txs 2 to 5, at heights 1 to 4, and
+	// expire at heights 262975 to 262978. The claims in txs 2 and 4 are in
+	// channel cc.
+	tests := []struct {
+		name        string
+		minHeight   uint32
+		maxHeight   uint32
+		channelHash []byte
+		offset      int
+		limit       int
+		want        []uint32
+	}{
+		{"range", 262976, 262978, nil, 0, 10, []uint32{3, 4, 5}},
+		{"single height", 262975, 262975, nil, 0, 10, []uint32{2}},
+		{"page", 262970, 262980, nil, 1, 2, []uint32{3, 4}},
+		{"channel", 0, 300000, bytes.Repeat([]byte{0xcc}, 20), 0, 10, []uint32{2, 4}},
+		{"channel page", 0, 300000, bytes.Repeat([]byte{0xcc}, 20), 1, 10, []uint32{4}},
+		{"channel range", 262976, 262978, bytes.Repeat([]byte{0xcc}, 20), 0, 10, []uint32{4}},
+		{"channel limit", 0, 300000, bytes.Repeat([]byte{0xcc}, 20), 0, 1, []uint32{2}},
+		{"channel past the end", 0, 300000, bytes.Repeat([]byte{0xcc}, 20), 2, 10, []uint32{}},
+		{"empty", 262979, 300000, nil, 0, 10, []uint32{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expirations, err := db.GetExpirations(tt.minHeight, tt.maxHeight, tt.channelHash, tt.offset, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(expirations) != len(tt.want) {
+				t.Fatalf("Expected %d expirations, got %d", len(tt.want), len(expirations))
+			}
+			for i, txNum := range tt.want {
+				got := expirations[i]
+				if got.TxNum != txNum || got.Height != txNum-1 || got.ExpirationHeight != txNum-1+dbpkg.OriginalClaimExpirationTime {
+					t.Errorf("Expected the claim in tx %d, got %+v", txNum, got)
+				}
+			}
+		})
+	}
+}
+
+func TestActivationDelay(t *testing.T) {
+	tests := []struct {
+		height         uint32
+		takeoverHeight uint32
+		want           uint32
+	}{
+		{100, 100, 0},
+		{131, 100, 0},
+		{132, 100, 1},
+		{100, 36, 2},
+		{dbpkg.MaxTakeoverDelay*dbpkg.ProportionalDelayFactor + 100, 0, dbpkg.MaxTakeoverDelay},
+	}
+	for _, tt := range tests {
+		if got := dbpkg.ActivationDelay(tt.height, tt.takeoverHeight); got != tt.want {
+			t.Errorf("Expected a delay of %d at height %d after a takeover at %d, got %d", tt.want, tt.height, tt.takeoverHeight, got)
+		}
+	}
+}
+
+func TestPredictTakeover(t *testing.T) {
+	filePath := "../testdata/Q_name_bids.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+	db.Height = 10
+
+	// aa controls test with 100 over bb's 50, cc's claim for 200 activates
+	// at 12 and takes over, which activates the support for aa at 13 early.
+	prediction, err := db.PredictTakeover("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prediction.Height != 12 || !bytes.Equal(prediction.ClaimHash, bytes.Repeat([]byte{0xcc}, 20)) || prediction.EffectiveAmount != 200 {
+		t.Errorf("Expected cc to take over at 12 with 200, got %+v", prediction)
+	}
+	if prediction.NextActivationHeight != 11 {
+		t.Errorf("Expected a new bid to activate at 11, got %d", prediction.NextActivationHeight)
+	}
+
+	// The claim pending for tie only matches the controlling claim.
+	prediction, err = db.PredictTakeover("tie")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prediction.Height != 0 || prediction.ClaimHash != nil {
+		t.Errorf("Expected no takeover, got %+v", prediction)
+	}
+
+	predictions, err := db.PredictTakeovers(11)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(predictions) != 0 {
+		t.Errorf("Expected no takeovers by 11, got %d", len(predictions))
+	}
+	predictions, err = db.PredictTakeovers(20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(predictions) != 2 || predictions[0].NormalizedName != "other" || predictions[1].NormalizedName != "test" {
+		t.Errorf("Expected takeovers of other and test, got %+v", predictions)
+	}
+	// The support for aa activating at 13 is past 12 but still activates
+	// early in the takeover of test.
+	predictions, err = db.PredictTakeovers(12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(predictions) != 2 || predictions[1].NormalizedName != "test" {
+		t.Fatalf("Expected takeovers of other and test, got %+v", predictions)
+	}
+	if prediction := predictions[1]; prediction.Height != 12 || !bytes.Equal(prediction.ClaimHash, bytes.Repeat([]byte{0xcc}, 20)) || prediction.EffectiveAmount != 200 {
+		t.Errorf("Expected cc to take over test at 12 with 200, got %+v", prediction)
+	}
+}
+
+func TestCheckClaimSignature(t *testing.T) {
+	filePath := "../testdata/E_signatures.csv"
+	db, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer toDefer()
+
+	// Channel cc signs the claims in txs 2 and 3, but the signature in tx 3
+	// leaves out the nout of its first input, so it's over the wrong digest
+	// even though it's stored as valid. The claim
+	// in tx 4 isn't signed and the one in tx 5 is signed by a channel that
+	// doesn't exist.
+	channelHash := bytes.Repeat([]byte{0xcc}, 20)
+	tests := []struct {
+		claimHash   []byte
+		channelHash []byte
+		valid       bool
+		storedValid bool
+	}{
+		{bytes.Repeat([]byte{2}, 20), channelHash, true, true},
+		{bytes.Repeat([]byte{3}, 20), channelHash, false, true},
+		{bytes.Repeat([]byte{4}, 20), nil, false, false},
+		{bytes.Repeat([]byte{5}, 20), bytes.Repeat([]byte{0xdd}, 20), false, false},
+	}
+	for _, tt := range tests {
+		check, err := db.CheckClaimSignature(tt.claimHash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(check.ChannelHash, tt.channelHash) || check.Valid != tt.valid || check.StoredValid != tt.storedValid {
+			t.Errorf("Expected %x signed by %x valid %v stored %v, got %+v", tt.claimHash, tt.channelHash, tt.valid, tt.storedValid, check)
+		}
+	}
+
+	check, err := db.CheckClaimSignature(bytes.Repeat([]byte{6}, 20))
+	if err != nil || check != nil {
+		t.Errorf("Expected nil for a missing claim, got %+v, %v", check, err)
+	}
+}
+
+func TestVerifyClaimSignature(t *testing.T) {
+	// A bad signature length or public key is an error.
+	if _, err := dbpkg.VerifyClaimSignature(nil, make([]byte, 63), make([]byte, 32)); err == nil {
+		t.Error("Expected an error for a short signature")
+	}
+	if _, err := dbpkg.VerifyClaimSignature([]byte{1, 2, 3}, make([]byte, 64), make([]byte, 32)); err == nil {
+		t.Error("Expected an error for a bad public key")
+	}
 }
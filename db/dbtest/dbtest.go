@@ -0,0 +1,121 @@
+// Package dbtest contains helpers for tests that need a db filled with test
+// data.
+package dbtest
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/lbryio/herald/db"
+	"github.com/lbryio/herald/db/prefixes"
+	"github.com/linxGnu/grocksdb"
+	log "github.com/sirupsen/logrus"
+)
+
+// OpenAndFillTmpDBColumnFamlies opens a db in a temporary directory and
+// fills it with data from a csv file using the given column family names.
+// The returned func closes the db and removes the directory.
+func OpenAndFillTmpDBColumnFamlies(filePath string) (*db.ReadOnlyDBColumnFamily, [][]string, func(), error) {
+
+	log.Println(filePath)
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer file.Close()
+	reader := csv.NewReader(file)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "herald-db-")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	wOpts := grocksdb.NewDefaultWriteOptions()
+	opts := grocksdb.NewDefaultOptions()
+	opts.SetCreateIfMissing(true)
+	rdb, err := grocksdb.OpenDb(opts, dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, nil, err
+	}
+	toDefer := func() {
+		rdb.Close()
+		err := os.RemoveAll(dir)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+	var handleMap map[string]*grocksdb.ColumnFamilyHandle = make(map[string]*grocksdb.ColumnFamilyHandle)
+
+	// Make sure we always create the TxCounts column family
+	var cfNameRunes string = records[0][0]
+	txCountPrefix := string(prefixes.TxCount)
+	if !strings.Contains(cfNameRunes, txCountPrefix) {
+		cfNameRunes = cfNameRunes + txCountPrefix
+	}
+	for _, cfNameRune := range cfNameRunes {
+		cfName := string(cfNameRune)
+		log.Println(cfName)
+		handle, err := rdb.CreateColumnFamily(opts, cfName)
+		if err != nil {
+			toDefer()
+			return nil, nil, nil, err
+		}
+		handleMap[cfName] = handle
+	}
+	for _, record := range records[1:] {
+		cf := record[0]
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		handle := handleMap[string(cf)]
+		key, err := hex.DecodeString(record[1])
+		if err != nil {
+			toDefer()
+			return nil, nil, nil, err
+		}
+		val, err := hex.DecodeString(record[2])
+		if err != nil {
+			toDefer()
+			return nil, nil, nil, err
+		}
+		rdb.PutCF(wOpts, handle, key, val)
+	}
+
+	myDB := &db.ReadOnlyDBColumnFamily{
+		DB:               rdb,
+		Handles:          handleMap,
+		Opts:             grocksdb.NewDefaultReadOptions(),
+		BlockedStreams:   make(map[string][]byte),
+		BlockedChannels:  make(map[string][]byte),
+		FilteredStreams:  make(map[string][]byte),
+		FilteredChannels: make(map[string][]byte),
+		TxCounts:         nil,
+		LastState:        nil,
+		Height:           0,
+		Headers:          nil,
+	}
+
+	// err = ReadDBState(myDB) //TODO: Figure out right place for this
+	// if err != nil {
+	// 	return nil, nil, nil, err
+	// }
+
+	err = myDB.InitTxCounts()
+	if err != nil {
+		toDefer()
+		return nil, nil, nil, err
+	}
+
+	// err = InitHeaders(myDB)
+	// if err != nil {
+	// 	return nil, nil, nil, err
+	// }
+
+	return myDB, records, toDefer, nil
+}
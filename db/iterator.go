@@ -0,0 +1,205 @@
+package db
+
+// iterator.go contains the pull based iterator over the rows of a rocksdb
+// column family.
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/lbryio/herald/db/prefixes"
+	"github.com/linxGnu/grocksdb"
+)
+
+// Iterator is a pull based iterator over the rows of a column family, in the
+// range given by the IterOptions. Rows are read by calling Next until it
+// returns false, and Close has to be called when done with it, whether or
+// not it ran to the end, to free the rocksdb iterator.
+type Iterator struct {
+	ctx     context.Context
+	opts    *IterOptions
+	ro      *grocksdb.ReadOptions
+	it      *grocksdb.Iterator
+	started bool
+	key     []byte
+	value   []byte
+	err     error
+	// lower and upper are the iterate bounds. The read options only keep a
+	// pointer to them, so they're kept alive here until Close.
+	lower []byte
+	upper []byte
+}
+
+// NewIterator creates an iterator over the rows of db in the range given by
+// opts. The range is passed to rocksdb as the iterate bounds. In reverse it
+// starts at the last key before the upper bound, found with SeekForPrev.
+// Iteration stops when ctx is done.
+func NewIterator(ctx context.Context, db *grocksdb.DB, opts *IterOptions) *Iterator {
+	ro := grocksdb.NewDefaultReadOptions()
+	ro.SetFillCache(opts.FillCache)
+	lower, upper := opts.bounds()
+	if lower != nil {
+		ro.SetIterateLowerBound(lower)
+	}
+	if upper != nil {
+		ro.SetIterateUpperBound(upper)
+	}
+
+	var it *grocksdb.Iterator
+	if opts.CfHandle != nil {
+		it = db.NewIteratorCF(ro, opts.CfHandle)
+	} else {
+		it = db.NewIterator(ro)
+	}
+	if opts.Reverse {
+		if upper != nil {
+			it.SeekForPrev(upper)
+			// The upper bound is exclusive, but SeekForPrev lands on it if
+			// it's a key.
+			if it.Valid() {
+				key := it.Key()
+				if bytes.Compare(key.Data(), upper) >= 0 {
+					it.Prev()
+				}
+				key.Free()
+			}
+		} else {
+			it.SeekToLast()
+		}
+	} else if lower != nil {
+		it.Seek(lower)
+	} else {
+		it.SeekToFirst()
+	}
+
+	return &Iterator{
+		ctx:   ctx,
+		opts:  opts,
+		ro:    ro,
+		it:    it,
+		lower: lower,
+		upper: upper,
+	}
+}
+
+// Next moves to the next row, or the previous one in reverse, and returns
+// false when there are no more rows, the context is done or reading failed.
+// Err tells the difference.
+func (it *Iterator) Next() bool {
+	if it.it == nil || it.err != nil {
+		return false
+	}
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+	if it.started {
+		it.step()
+	}
+	it.started = true
+	it.key, it.value = nil, nil
+
+	for ; it.it.Valid(); it.step() {
+		key := it.it.Key()
+		skip := !it.opts.IncludeStart && it.opts.Start != nil && bytes.HasPrefix(key.Data(), it.opts.Start)
+		if !skip {
+			it.key = make([]byte, key.Size())
+			copy(it.key, key.Data())
+		}
+		key.Free()
+		if !skip {
+			return true
+		}
+	}
+	it.err = it.it.Err()
+	return false
+}
+
+// Seek moves a forward iterator to the first row at or after key, which the
+// next call to Next returns. Rows outside the range of the options are still
+// left out.
+func (it *Iterator) Seek(key []byte) {
+	if it.it == nil || it.err != nil {
+		return
+	}
+	if it.lower != nil && bytes.Compare(key, it.lower) < 0 {
+		key = it.lower
+	}
+	it.it.Seek(key)
+	it.started = false
+	it.key, it.value = nil, nil
+}
+
+// step moves the rocksdb iterator one row in the direction of the iteration.
+func (it *Iterator) step() {
+	if it.opts.Reverse {
+		it.it.Prev()
+	} else {
+		it.it.Next()
+	}
+}
+
+// Key returns the raw key of the current row.
+func (it *Iterator) Key() []byte {
+	return it.key
+}
+
+// Value returns the raw value of the current row. It's only copied out of
+// rocksdb when it's asked for.
+func (it *Iterator) Value() []byte {
+	if it.value == nil && it.key != nil {
+		value := it.it.Value()
+		it.value = make([]byte, value.Size())
+		copy(it.value, value.Data())
+		value.Free()
+	}
+	return it.value
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close frees the rocksdb iterator. It's safe to call more than once.
+func (it *Iterator) Close() {
+	if it.it == nil {
+		return
+	}
+	it.it.Close()
+	it.ro.Destroy()
+	it.it = nil
+	it.lower, it.upper = nil, nil
+	it.key, it.value = nil, nil
+}
+
+// TypedIterator is an Iterator over the rows of one prefix that unpacks them
+// with the prefix's codec.
+type TypedIterator[K, V any] struct {
+	*Iterator
+	codec *prefixes.TypedCodec[K, V]
+}
+
+// NewTypedIterator creates an iterator over the rows of the prefix of codec in
+// the range given by opts.
+func NewTypedIterator[K, V any](ctx context.Context, db *grocksdb.DB, codec *prefixes.TypedCodec[K, V], opts *IterOptions) *TypedIterator[K, V] {
+	return &TypedIterator[K, V]{
+		Iterator: NewIterator(ctx, db, opts),
+		codec:    codec,
+	}
+}
+
+// Key returns the unpacked key of the current row.
+func (it *TypedIterator[K, V]) Key() K {
+	return it.codec.UnpackTypedKey(it.Iterator.Key())
+}
+
+// Value returns the unpacked value of the current row.
+func (it *TypedIterator[K, V]) Value() V {
+	return it.codec.UnpackTypedValue(it.Iterator.Value())
+}
+
+// RawKey returns the raw key of the current row.
+func (it *TypedIterator[K, V]) RawKey() []byte {
+	return it.Iterator.Key()
+}
@@ -5,10 +5,7 @@ package db
 import (
 	"bytes"
 
-	"github.com/lbryio/herald/db/prefixes"
 	"github.com/linxGnu/grocksdb"
-
-	log "github.com/sirupsen/logrus"
 )
 
 type IterOptions struct {
@@ -22,8 +19,10 @@ type IterOptions struct {
 	IncludeValue bool
 	RawKey       bool
 	RawValue     bool
-	CfHandle     *grocksdb.ColumnFamilyHandle
-	It           *grocksdb.Iterator
+	// Reverse iterates from the end of the range to its start. The range,
+	// and whether Start and Stop are in it, stay the same.
+	Reverse  bool
+	CfHandle *grocksdb.ColumnFamilyHandle
 }
 
 // NewIterateOptions creates a defualt options structure for a db iterator.
@@ -39,8 +38,8 @@ func NewIterateOptions() *IterOptions {
 		IncludeValue: false,
 		RawKey:       false,
 		RawValue:     false,
+		Reverse:      false,
 		CfHandle:     nil,
-		It:           nil,
 	}
 }
 
@@ -99,92 +98,47 @@ func (o *IterOptions) WithRawValue(rawValue bool) *IterOptions {
 	return o
 }
 
-// ReadRow reads a row from the db, returns nil when no more rows are available.
-func (opts *IterOptions) ReadRow(prevKey *[]byte) *prefixes.PrefixRowKV {
-	it := opts.It
-	if !it.Valid() {
-		log.Trace("ReadRow iterator not valid returning nil")
-		return nil
-	}
-
-	key := it.Key()
-	defer key.Free()
-	keyData := key.Data()
-	keyLen := len(keyData)
-
-	value := it.Value()
-	defer value.Free()
-	valueData := value.Data()
-	valueLen := len(valueData)
-
-	var outKey interface{} = nil
-	var outValue interface{} = nil
-	var err error = nil
-
-	log.Trace("keyData:", keyData)
-	log.Trace("valueData:", valueData)
+func (o *IterOptions) WithReverse(reverse bool) *IterOptions {
+	o.Reverse = reverse
+	return o
+}
 
-	// We need to check the current key if we're not including the stop
-	// key.
-	if !opts.IncludeStop && opts.StopIteration(keyData) {
-		log.Trace("ReadRow returning nil")
-		return nil
+// bounds returns the lower and upper bounds of the keys in the range of
+// the options. The lower bound is inclusive and the upper bound exclusive,
+// either is nil if there's none. Keys in the range start with Prefix, are
+// at or after Start and are before Stop, or have the prefix Stop if
+// IncludeStop is set.
+func (o *IterOptions) bounds() ([]byte, []byte) {
+	var lower, upper []byte
+	if len(o.Prefix) > 0 {
+		lower = o.Prefix
+		upper = prefixSuccessor(o.Prefix)
 	}
-
-	// We have to copy the key no matter what because we need to check
-	// it on the next iterations to see if we're going to stop.
-	newKeyData := make([]byte, keyLen)
-	copy(newKeyData, keyData)
-	if opts.IncludeKey && !opts.RawKey {
-		outKey, err = prefixes.UnpackGenericKey(newKeyData)
-		if err != nil {
-			log.Error(err)
-		}
-	} else if opts.IncludeKey {
-		outKey = newKeyData
+	if o.Start != nil && bytes.Compare(o.Start, lower) > 0 {
+		lower = o.Start
 	}
-
-	// Value could be quite large, so this setting could be important
-	// for performance in some cases.
-	if opts.IncludeValue {
-		newValueData := make([]byte, valueLen)
-		copy(newValueData, valueData)
-		if !opts.RawValue {
-			outValue, err = prefixes.UnpackGenericValue(newKeyData, newValueData)
-			if err != nil {
-				log.Error(err)
-			}
-		} else {
-			outValue = newValueData
+	if o.Stop != nil {
+		stop := o.Stop
+		if o.IncludeStop {
+			stop = prefixSuccessor(o.Stop)
+		}
+		if stop != nil && (upper == nil || bytes.Compare(stop, upper) < 0) {
+			upper = stop
 		}
 	}
-
-	kv := &prefixes.PrefixRowKV{
-		Key:   outKey,
-		Value: outValue,
-	}
-	*prevKey = newKeyData
-
-	return kv
+	return lower, upper
 }
 
-// StopIteration returns true if we've hit the criteria to end iteration on this key
-func (o *IterOptions) StopIteration(key []byte) bool {
-	if key == nil {
-		return false
-	}
-
-	maxLenStop := intMin(len(key), len(o.Stop))
-	maxLenStart := intMin(len(key), len(o.Start))
-	if o.Stop != nil &&
-		(bytes.HasPrefix(key, o.Stop) || bytes.Compare(o.Stop, key[:maxLenStop]) < 0) {
-		return true
-	} else if o.Start != nil &&
-		bytes.Compare(o.Start, key[:maxLenStart]) > 0 {
-		return true
-	} else if o.Prefix != nil && !bytes.HasPrefix(key, o.Prefix) {
-		return true
+// prefixSuccessor returns the smallest key after all the keys starting with
+// prefix, or nil if there's none.
+func prefixSuccessor(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] != 0xff {
+			res := make([]byte, i+1)
+			copy(res, prefix)
+			res[i]++
+			return res
+		}
 	}
-
-	return false
+	return nil
 }
@@ -0,0 +1,186 @@
+package prefixes
+
+// codecs.go registers the codec of each prefix.
+
+var (
+	ClaimToSupportCodec = Register(NewTypedCodec(
+		ClaimToSupport, "claim_to_support", []string{"claim_hash", "tx_num", "position"},
+		ClaimToSupportKeyUnpack, ClaimToSupportValueUnpack,
+		(*ClaimToSupportKey).PackKey, (*ClaimToSupportValue).PackValue,
+		ClaimToSupportKeyPackPartial,
+	))
+	SupportToClaimCodec = Register(NewTypedCodec(
+		SupportToClaim, "support_to_claim", []string{"tx_num", "position"},
+		SupportToClaimKeyUnpack, SupportToClaimValueUnpack,
+		(*SupportToClaimKey).PackKey, (*SupportToClaimValue).PackValue,
+		SupportToClaimKeyPackPartial,
+	))
+	ClaimToTXOCodec = Register(NewTypedCodec(
+		ClaimToTXO, "claim_to_txo", []string{"claim_hash"},
+		ClaimToTXOKeyUnpack, ClaimToTXOValueUnpack,
+		(*ClaimToTXOKey).PackKey, (*ClaimToTXOValue).PackValue,
+		ClaimToTXOKeyPackPartial,
+	))
+	TXOToClaimCodec = Register(NewTypedCodec(
+		TXOToClaim, "txo_to_claim", []string{"tx_num", "position"},
+		TXOToClaimKeyUnpack, TXOToClaimValueUnpack,
+		(*TXOToClaimKey).PackKey, (*TXOToClaimValue).PackValue,
+		TXOToClaimKeyPackPartial,
+	))
+	ClaimToChannelCodec = Register(NewTypedCodec(
+		ClaimToChannel, "claim_to_channel", []string{"claim_hash", "tx_num", "position"},
+		ClaimToChannelKeyUnpack, ClaimToChannelValueUnpack,
+		(*ClaimToChannelKey).PackKey, (*ClaimToChannelValue).PackValue,
+		ClaimToChannelKeyPackPartial,
+	))
+	ChannelToClaimCodec = Register(NewTypedCodec(
+		ChannelToClaim, "channel_to_claim", []string{"signing_hash", "name", "tx_num", "position"},
+		ChannelToClaimKeyUnpack, ChannelToClaimValueUnpack,
+		(*ChannelToClaimKey).PackKey, (*ChannelToClaimValue).PackValue,
+		ChannelToClaimKeyPackPartial,
+	))
+	ClaimShortIDCodec = Register(NewTypedCodec(
+		ClaimShortIdPrefix, "claim_short_id", []string{"normalized_name", "partial_claim_id", "root_tx_num", "root_position"},
+		ClaimShortIDKeyUnpack, ClaimShortIDValueUnpack,
+		(*ClaimShortIDKey).PackKey, (*ClaimShortIDValue).PackValue,
+		ClaimShortIDKeyPackPartial,
+	))
+	EffectiveAmountCodec = Register(NewTypedCodec(
+		EffectiveAmount, "effective_amount", []string{"normalized_name", "effective_amount", "tx_num", "position"},
+		EffectiveAmountKeyUnpack, EffectiveAmountValueUnpack,
+		(*EffectiveAmountKey).PackKey, (*EffectiveAmountValue).PackValue,
+		EffectiveAmountKeyPackPartial,
+	))
+	ClaimExpirationCodec = Register(NewTypedCodec(
+		ClaimExpiration, "claim_expiration", []string{"expiration", "tx_num", "position"},
+		ClaimExpirationKeyUnpack, ClaimExpirationValueUnpack,
+		(*ClaimExpirationKey).PackKey, (*ClaimExpirationValue).PackValue,
+		ClaimExpirationKeyPackPartial,
+	))
+	ClaimTakeoverCodec = Register(NewTypedCodec(
+		ClaimTakeover, "claim_takeover", []string{"normalized_name"},
+		ClaimTakeoverKeyUnpack, ClaimTakeoverValueUnpack,
+		(*ClaimTakeoverKey).PackKey, (*ClaimTakeoverValue).PackValue,
+		ClaimTakeoverKeyPackPartial,
+	))
+	PendingActivationCodec = Register(NewTypedCodec(
+		PendingActivation, "pending_activation", []string{"height", "txo_type", "tx_num", "position"},
+		PendingActivationKeyUnpack, PendingActivationValueUnpack,
+		(*PendingActivationKey).PackKey, (*PendingActivationValue).PackValue,
+		PendingActivationKeyPackPartial,
+	))
+	ActivationCodec = Register(NewTypedCodec(
+		ActivatedClaimAndSupport, "activated", []string{"txo_type", "tx_num", "position"},
+		ActivationKeyUnpack, ActivationValueUnpack,
+		(*ActivationKey).PackKey, (*ActivationValue).PackValue,
+		ActivationKeyPackPartial,
+	))
+	ActiveAmountCodec = Register(NewTypedCodec(
+		ActiveAmount, "active_amount", []string{"claim_hash", "txo_type", "activation_height", "tx_num", "position"},
+		ActiveAmountKeyUnpack, ActiveAmountValueUnpack,
+		(*ActiveAmountKey).PackKey, (*ActiveAmountValue).PackValue,
+		ActiveAmountKeyPackPartial,
+	))
+	RepostCodec = Register(NewTypedCodec(
+		Repost, "repost", []string{"claim_hash"},
+		RepostKeyUnpack, RepostValueUnpack,
+		(*RepostKey).PackKey, (*RepostValue).PackValue,
+		RepostKeyPackPartial,
+	))
+	RepostedCodec = Register(NewTypedCodec(
+		RepostedClaim, "reposted_claim", []string{"reposted_claim_hash", "tx_num", "position"},
+		RepostedKeyUnpack, RepostedValueUnpack,
+		(*RepostedKey).PackKey, (*RepostedValue).PackValue,
+		RepostedKeyPackPartial,
+	))
+	UndoCodec = Register(NewTypedCodec(
+		Undo, "undo", []string{"height"},
+		UndoKeyUnpack, UndoValueUnpack,
+		(*UndoKey).PackKey, (*UndoValue).PackValue,
+		UndoKeyPackPartial,
+	))
+	TouchedOrDeletedClaimCodec = Register(NewTypedCodec(
+		ClaimDiff, "touched_or_deleted", []string{"height"},
+		TouchedOrDeletedClaimKeyUnpack, TouchedOrDeletedClaimValueUnpack,
+		(*TouchedOrDeletedClaimKey).PackKey, (*TouchedOrDeletedClaimValue).PackValue,
+		TouchedOrDeletedClaimKeyPackPartial,
+	))
+	TxCodec = Register(NewTypedCodec(
+		Tx, "tx", []string{"tx_hash"},
+		TxKeyUnpack, TxValueUnpack,
+		(*TxKey).PackKey, (*TxValue).PackValue,
+		TxKeyPackPartial,
+	))
+	BlockHashCodec = Register(NewTypedCodec(
+		BlockHash, "block_hash", []string{"height"},
+		BlockHashKeyUnpack, BlockHashValueUnpack,
+		(*BlockHashKey).PackKey, (*BlockHashValue).PackValue,
+		BlockHashKeyPackPartial,
+	))
+	BlockHeaderCodec = Register(NewTypedCodec(
+		Header, "header", []string{"height"},
+		BlockHeaderKeyUnpack, BlockHeaderValueUnpack,
+		(*BlockHeaderKey).PackKey, (*BlockHeaderValue).PackValue,
+		BlockHeaderKeyPackPartial,
+	))
+	TxNumCodec = Register(NewTypedCodec(
+		TxNum, "tx_num", []string{"tx_hash"},
+		TxNumKeyUnpack, TxNumValueUnpack,
+		(*TxNumKey).PackKey, (*TxNumValue).PackValue,
+		TxNumKeyPackPartial,
+	))
+	TxCountCodec = Register(NewTypedCodec(
+		TxCount, "tx_count", []string{"height"},
+		TxCountKeyUnpack, TxCountValueUnpack,
+		(*TxCountKey).PackKey, (*TxCountValue).PackValue,
+		TxCountKeyPackPartial,
+	))
+	TxHashCodec = Register(NewTypedCodec(
+		TxHash, "tx_hash", []string{"tx_num"},
+		TxHashKeyUnpack, TxHashValueUnpack,
+		(*TxHashKey).PackKey, (*TxHashValue).PackValue,
+		TxHashKeyPackPartial,
+	))
+	UTXOCodec = Register(NewTypedCodec(
+		UTXO, "utxo", []string{"hashx", "tx_num", "nout"},
+		UTXOKeyUnpack, UTXOValueUnpack,
+		(*UTXOKey).PackKey, (*UTXOValue).PackValue,
+		UTXOKeyPackPartial,
+	))
+	HashXUTXOCodec = Register(NewTypedCodec(
+		HashXUTXO, "hashx_utxo", []string{"short_tx_hash", "tx_num", "nout"},
+		HashXUTXOKeyUnpack, HashXUTXOValueUnpack,
+		(*HashXUTXOKey).PackKey, (*HashXUTXOValue).PackValue,
+		HashXUTXOKeyPackPartial,
+	))
+	HashXHistoryCodec = Register(NewTypedCodec(
+		HashXHistory, "hashx_history", []string{"hashx", "height"},
+		HashXHistoryKeyUnpack, HashXHistoryValueUnpack,
+		(*HashXHistoryKey).PackKey, (*HashXHistoryValue).PackValue,
+		HashXHistoryKeyPackPartial,
+	))
+	DBStateCodec = Register(NewTypedCodec(
+		DBState, "db_state", []string{},
+		DBStateKeyUnpack, DBStateValueUnpack,
+		(*DBStateKey).PackKey, (*DBStateValue).PackValue,
+		DBStateKeyPackPartial,
+	))
+	ChannelCountCodec = Register(NewTypedCodec(
+		ChannelCount, "channel_count", []string{"channel_hash"},
+		ChannelCountKeyUnpack, ChannelCountValueUnpack,
+		(*ChannelCountKey).PackKey, (*ChannelCountValue).PackValue,
+		ChannelCountKeyPackPartial,
+	))
+	SupportAmountCodec = Register(NewTypedCodec(
+		SupportAmount, "support_amount", []string{"claim_hash"},
+		SupportAmountKeyUnpack, SupportAmountValueUnpack,
+		(*SupportAmountKey).PackKey, (*SupportAmountValue).PackValue,
+		SupportAmountKeyPackPartial,
+	))
+	BlockTxsCodec = Register(NewTypedCodec(
+		BlockTXs, "block_txs", []string{"height"},
+		BlockTxsKeyUnpack, BlockTxsValueUnpack,
+		(*BlockTxsKey).PackKey, (*BlockTxsValue).PackValue,
+		BlockTxsKeyPackPartial,
+	))
+)
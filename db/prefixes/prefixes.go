@@ -6,6 +6,7 @@ package prefixes
 // it into bytes for storage in rocksdb. They also have a pack partial function which allows
 // for serialization of a set number of fields, this is used i.e. for keys with a hash, tx_num
 // and height where there multiple be multiple with the same hash but different tx_num and height.
+// The functions of each prefix are registered in a codec, see registry.go and codecs.go.
 
 import (
 	"bytes"
@@ -67,40 +68,14 @@ const (
 	OnesCompTwiddle32 uint32 = 0xffffffff
 )
 
-// GetPrefixes returns an array of all the byte prefix constants.
+// GetPrefixes returns the prefixes of all the registered codecs.
 func GetPrefixes() [][]byte {
-	return [][]byte{
-		{ClaimToSupport},
-		{SupportToClaim},
-		{ClaimToTXO},
-		{TXOToClaim},
-		{ClaimToChannel},
-		{ChannelToClaim},
-		{ClaimShortIdPrefix},
-		{EffectiveAmount},
-		{ClaimExpiration},
-		{ClaimTakeover},
-		{PendingActivation},
-		{ActivatedClaimAndSupport},
-		{ActiveAmount},
-		{Repost},
-		{RepostedClaim},
-		{Undo},
-		{ClaimDiff},
-		{Tx},
-		{BlockHash},
-		{Header},
-		{TxNum},
-		{TxCount},
-		{TxHash},
-		{UTXO},
-		{HashXUTXO},
-		{HashXHistory},
-		{DBState},
-		{ChannelCount},
-		{SupportAmount},
-		{BlockTXs},
+	codecs := Codecs()
+	res := make([][]byte, 0, len(codecs))
+	for _, codec := range codecs {
+		res = append(res, []byte{codec.Prefix()})
 	}
+	return res
 }
 
 // PrefixRowKV is a generic key/value pair for a prefix.
@@ -191,18 +166,6 @@ func (v *DBStateValue) PackValue() []byte {
 	return value
 }
 
-func DBStateKeyPackPartialKey(key *DBStateKey) func(int) []byte {
-	return func(fields int) []byte {
-		return DBStateKeyPackPartial(key, fields)
-	}
-}
-
-func DBStateKeyPackPartialfields(fields int) func(*DBStateKey) []byte {
-	return func(u *DBStateKey) []byte {
-		return DBStateKeyPackPartial(u, fields)
-	}
-}
-
 func DBStateKeyPackPartial(k *DBStateKey, fields int) []byte {
 	prefixLen := 1
 	var n = prefixLen
@@ -249,6 +212,13 @@ type UndoValue struct {
 	Data []byte `json:"data"`
 }
 
+func NewUndoKey(height uint64) *UndoKey {
+	return &UndoKey{
+		Prefix: []byte{Undo},
+		Height: height,
+	}
+}
+
 func (k *UndoKey) PackKey() []byte {
 	prefixLen := 1
 	// b'>L'
@@ -268,18 +238,6 @@ func (v *UndoValue) PackValue() []byte {
 	return value
 }
 
-func UndoKeyPackPartialKey(key *UndoKey) func(int) []byte {
-	return func(fields int) []byte {
-		return UndoKeyPackPartial(key, fields)
-	}
-}
-
-func UndoKeyPackPartialfields(fields int) func(*UndoKey) []byte {
-	return func(u *UndoKey) []byte {
-		return UndoKeyPackPartial(u, fields)
-	}
-}
-
 func UndoKeyPackPartial(k *UndoKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -392,20 +350,6 @@ func (v *HashXUTXOValue) PackValue() []byte {
 	return value
 }
 
-// HashXUTXOKeyPackPartialKey creates a pack partial key function for n fields.
-func HashXUTXOKeyPackPartialKey(key *HashXUTXOKey) func(int) []byte {
-	return func(fields int) []byte {
-		return HashXUTXOKeyPackPartial(key, fields)
-	}
-}
-
-// HashXUTXOKeyPackPartialfields creates a pack partial key function for n fields.
-func HashXUTXOKeyPackPartialfields(fields int) func(*HashXUTXOKey) []byte {
-	return func(u *HashXUTXOKey) []byte {
-		return HashXUTXOKeyPackPartial(u, fields)
-	}
-}
-
 // HashXUTXOKeyPackPartial packs a variable number of fields into a byte
 // array
 func HashXUTXOKeyPackPartial(k *HashXUTXOKey, fields int) []byte {
@@ -472,8 +416,10 @@ type HashXHistoryKey struct {
 	Height uint32 `json:"height"`
 }
 
+// HashXHistoryValue is the tx nums touching a hashX at a height. They're
+// stored as little endian uint32s.
 type HashXHistoryValue struct {
-	HashXes []uint16 `json:"hashxes"`
+	HashXes []uint32 `json:"hashxes"`
 }
 
 func (k *HashXHistoryKey) String() string {
@@ -499,28 +445,14 @@ func (k *HashXHistoryKey) PackKey() []byte {
 
 func (v *HashXHistoryValue) PackValue() []byte {
 	n := len(v.HashXes)
-	value := make([]byte, n*2)
+	value := make([]byte, n*4)
 	for i, x := range v.HashXes {
-		binary.BigEndian.PutUint16(value[i*2:], x)
+		binary.LittleEndian.PutUint32(value[i*4:], x)
 	}
 
 	return value
 }
 
-// HashXHistoryKeyPackPartialKey creates a pack partial key function for n fields.
-func HashXHistoryKeyPackPartialKey(key *HashXHistoryKey) func(int) []byte {
-	return func(fields int) []byte {
-		return HashXHistoryKeyPackPartial(key, fields)
-	}
-}
-
-// HashXHistoryKeyPackPartialfields creates a pack partial key function for n fields.
-func HashXHistoryKeyPackPartialfields(fields int) func(*HashXHistoryKey) []byte {
-	return func(u *HashXHistoryKey) []byte {
-		return HashXHistoryKeyPackPartial(u, fields)
-	}
-}
-
 // HashXHistoryKeyPackPartial packs a variable number of fields into a byte
 // array
 func HashXHistoryKeyPackPartial(k *HashXHistoryKey, fields int) []byte {
@@ -570,10 +502,10 @@ func HashXHistoryKeyUnpack(key []byte) *HashXHistoryKey {
 }
 
 func HashXHistoryValueUnpack(value []byte) *HashXHistoryValue {
-	n := len(value) / 2
-	hashxes := make([]uint16, n)
+	n := len(value) / 4
+	hashxes := make([]uint32, n)
 	for i := 0; i < n; i++ {
-		hashxes[i] = binary.BigEndian.Uint16(value[i*2:])
+		hashxes[i] = binary.LittleEndian.Uint32(value[i*4:])
 	}
 	return &HashXHistoryValue{
 		HashXes: hashxes,
@@ -614,18 +546,6 @@ func (v *BlockHashValue) PackValue() []byte {
 	return value
 }
 
-func BlockHashKeyPackPartialKey(key *BlockHashKey) func(int) []byte {
-	return func(fields int) []byte {
-		return BlockHashKeyPackPartial(key, fields)
-	}
-}
-
-func BlockHashKeyPackPartialfields(fields int) func(*BlockHashKey) []byte {
-	return func(u *BlockHashKey) []byte {
-		return BlockHashKeyPackPartial(u, fields)
-	}
-}
-
 func BlockHashKeyPackPartial(k *BlockHashKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -722,18 +642,6 @@ func (v *BlockTxsValue) PackValue() []byte {
 	return value
 }
 
-func BlockTxsKeyPackPartialKey(key *BlockTxsKey) func(int) []byte {
-	return func(fields int) []byte {
-		return BlockTxsKeyPackPartial(key, fields)
-	}
-}
-
-func BlockTxsKeyPackPartialfields(fields int) func(*BlockTxsKey) []byte {
-	return func(u *BlockTxsKey) []byte {
-		return BlockTxsKeyPackPartial(u, fields)
-	}
-}
-
 func BlockTxsKeyPackPartial(k *BlockTxsKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -820,18 +728,6 @@ func (v *TxCountValue) PackValue() []byte {
 	return value
 }
 
-func TxCountKeyPackPartialKey(key *TxCountKey) func(int) []byte {
-	return func(fields int) []byte {
-		return TxCountKeyPackPartial(key, fields)
-	}
-}
-
-func TxCountKeyPackPartialfields(fields int) func(*TxCountKey) []byte {
-	return func(u *TxCountKey) []byte {
-		return TxCountKeyPackPartial(u, fields)
-	}
-}
-
 func TxCountKeyPackPartial(k *TxCountKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -914,18 +810,6 @@ func (v *TxHashValue) PackValue() []byte {
 	return value
 }
 
-func TxHashKeyPackPartialKey(key *TxHashKey) func(int) []byte {
-	return func(fields int) []byte {
-		return TxHashKeyPackPartial(key, fields)
-	}
-}
-
-func TxHashKeyPackPartialfields(fields int) func(*TxHashKey) []byte {
-	return func(u *TxHashKey) []byte {
-		return TxHashKeyPackPartial(u, fields)
-	}
-}
-
 func TxHashKeyPackPartial(k *TxHashKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -982,6 +866,13 @@ type TxNumValue struct {
 	TxNum uint32 `json:"tx_num"`
 }
 
+func NewTxNumKey(txHash *chainhash.Hash) *TxNumKey {
+	return &TxNumKey{
+		Prefix: []byte{TxNum},
+		TxHash: txHash,
+	}
+}
+
 func (k *TxNumKey) PackKey() []byte {
 	prefixLen := 1
 	// b'>L'
@@ -1000,18 +891,6 @@ func (v *TxNumValue) PackValue() []byte {
 	return value
 }
 
-func TxNumKeyPackPartialKey(key *TxNumKey) func(int) []byte {
-	return func(fields int) []byte {
-		return TxNumKeyPackPartial(key, fields)
-	}
-}
-
-func TxNumKeyPackPartialfields(fields int) func(*TxNumKey) []byte {
-	return func(u *TxNumKey) []byte {
-		return TxNumKeyPackPartial(u, fields)
-	}
-}
-
 func TxNumKeyPackPartial(k *TxNumKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1068,6 +947,13 @@ type TxValue struct {
 	RawTx []byte `json:"raw_tx"`
 }
 
+func NewTxKey(txHash *chainhash.Hash) *TxKey {
+	return &TxKey{
+		Prefix: []byte{Tx},
+		TxHash: txHash,
+	}
+}
+
 func (k *TxKey) PackKey() []byte {
 	prefixLen := 1
 	// b'>L'
@@ -1086,18 +972,6 @@ func (v *TxValue) PackValue() []byte {
 	return value
 }
 
-func TxKeyPackPartialKey(key *TxKey) func(int) []byte {
-	return func(fields int) []byte {
-		return TxKeyPackPartial(key, fields)
-	}
-}
-
-func TxKeyPackPartialfields(fields int) func(*TxKey) []byte {
-	return func(u *TxKey) []byte {
-		return TxKeyPackPartial(u, fields)
-	}
-}
-
 func TxKeyPackPartial(k *TxKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1183,18 +1057,6 @@ func (v *BlockHeaderValue) PackValue() []byte {
 	return value
 }
 
-func BlockHeaderKeyPackPartialKey(key *BlockHeaderKey) func(int) []byte {
-	return func(fields int) []byte {
-		return BlockHeaderKeyPackPartial(key, fields)
-	}
-}
-
-func BlockHeaderKeyPackPartialfields(fields int) func(*BlockHeaderKey) []byte {
-	return func(u *BlockHeaderKey) []byte {
-		return BlockHeaderKeyPackPartial(u, fields)
-	}
-}
-
 func BlockHeaderKeyPackPartial(k *BlockHeaderKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1301,18 +1163,6 @@ func (v *ClaimToTXOValue) PackValue() []byte {
 	return value
 }
 
-func ClaimToTXOKeyPackPartialKey(key *ClaimToTXOKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ClaimToTXOKeyPackPartial(key, fields)
-	}
-}
-
-func ClaimToTXOKeyPackPartialfields(fields int) func(*ClaimToTXOKey) []byte {
-	return func(u *ClaimToTXOKey) []byte {
-		return ClaimToTXOKeyPackPartial(u, fields)
-	}
-}
-
 func ClaimToTXOKeyPackPartial(k *ClaimToTXOKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1410,18 +1260,6 @@ func (v *TXOToClaimValue) PackValue() []byte {
 	return value
 }
 
-func TXOToClaimKeyPackPartialKey(key *TXOToClaimKey) func(int) []byte {
-	return func(fields int) []byte {
-		return TXOToClaimKeyPackPartial(key, fields)
-	}
-}
-
-func TXOToClaimKeyPackPartialfields(fields int) func(*TXOToClaimKey) []byte {
-	return func(u *TXOToClaimKey) []byte {
-		return TXOToClaimKeyPackPartial(u, fields)
-	}
-}
-
 func TXOToClaimKeyPackPartial(k *TXOToClaimKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1523,18 +1361,6 @@ func (v *ClaimShortIDValue) PackValue() []byte {
 	return value
 }
 
-func ClaimShortIDKeyPackPartialKey(key *ClaimShortIDKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ClaimShortIDKeyPackPartial(key, fields)
-	}
-}
-
-func ClaimShortIDKeyPackPartialfields(fields int) func(*ClaimShortIDKey) []byte {
-	return func(u *ClaimShortIDKey) []byte {
-		return ClaimShortIDKeyPackPartial(u, fields)
-	}
-}
-
 func ClaimShortIDKeyPackPartial(k *ClaimShortIDKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1646,18 +1472,6 @@ func (v *ClaimToChannelValue) PackValue() []byte {
 	return value
 }
 
-func ClaimToChannelKeyPackPartialKey(key *ClaimToChannelKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ClaimToChannelKeyPackPartial(key, fields)
-	}
-}
-
-func ClaimToChannelKeyPackPartialfields(fields int) func(*ClaimToChannelKey) []byte {
-	return func(u *ClaimToChannelKey) []byte {
-		return ClaimToChannelKeyPackPartial(u, fields)
-	}
-}
-
 func ClaimToChannelKeyPackPartial(k *ClaimToChannelKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1765,18 +1579,6 @@ func (v *ChannelToClaimValue) PackValue() []byte {
 	return value
 }
 
-func ChannelToClaimKeyPackPartialKey(key *ChannelToClaimKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ChannelToClaimKeyPackPartial(key, fields)
-	}
-}
-
-func ChannelToClaimKeyPackPartialfields(fields int) func(*ChannelToClaimKey) []byte {
-	return func(u *ChannelToClaimKey) []byte {
-		return ChannelToClaimKeyPackPartial(u, fields)
-	}
-}
-
 func ChannelToClaimKeyPackPartial(k *ChannelToClaimKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1876,18 +1678,6 @@ func (v *ChannelCountValue) PackValue() []byte {
 	return value
 }
 
-func ChannelCountKeyPackPartialKey(key *ChannelCountKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ChannelCountKeyPackPartial(key, fields)
-	}
-}
-
-func ChannelCountKeyPackPartialfields(fields int) func(*ChannelCountKey) []byte {
-	return func(u *ChannelCountKey) []byte {
-		return ChannelCountKeyPackPartial(u, fields)
-	}
-}
-
 func ChannelCountKeyPackPartial(k *ChannelCountKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -1969,18 +1759,6 @@ func (v *SupportAmountValue) PackValue() []byte {
 	return value
 }
 
-func SupportAmountKeyPackPartialKey(key *SupportAmountKey) func(int) []byte {
-	return func(fields int) []byte {
-		return SupportAmountKeyPackPartial(key, fields)
-	}
-}
-
-func SupportAmountKeyPackPartialfields(fields int) func(*SupportAmountKey) []byte {
-	return func(u *SupportAmountKey) []byte {
-		return SupportAmountKeyPackPartial(u, fields)
-	}
-}
-
 func SupportAmountKeyPackPartial(k *SupportAmountKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2039,6 +1817,15 @@ type ClaimToSupportValue struct {
 	Amount uint64 `json:"amount"`
 }
 
+func NewClaimToSupportKey(claimHash []byte, txNum uint32, position uint16) *ClaimToSupportKey {
+	return &ClaimToSupportKey{
+		Prefix:    []byte{ClaimToSupport},
+		ClaimHash: claimHash,
+		TxNum:     txNum,
+		Position:  position,
+	}
+}
+
 func (k *ClaimToSupportKey) PackKey() []byte {
 	prefixLen := 1
 	// b'>20sLH'
@@ -2059,18 +1846,6 @@ func (v *ClaimToSupportValue) PackValue() []byte {
 	return value
 }
 
-func ClaimToSupportKeyPackPartialKey(key *ClaimToSupportKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ClaimToSupportKeyPackPartial(key, fields)
-	}
-}
-
-func ClaimToSupportKeyPackPartialfields(fields int) func(*ClaimToSupportKey) []byte {
-	return func(u *ClaimToSupportKey) []byte {
-		return ClaimToSupportKeyPackPartial(u, fields)
-	}
-}
-
 func ClaimToSupportKeyPackPartial(k *ClaimToSupportKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2139,6 +1914,14 @@ type SupportToClaimValue struct {
 	ClaimHash []byte `json:"claim_hash"`
 }
 
+func NewSupportToClaimKey(txNum uint32, position uint16) *SupportToClaimKey {
+	return &SupportToClaimKey{
+		Prefix:   []byte{SupportToClaim},
+		TxNum:    txNum,
+		Position: position,
+	}
+}
+
 func (k *SupportToClaimKey) PackKey() []byte {
 	prefixLen := 1
 	// b'>LH'
@@ -2158,18 +1941,6 @@ func (v *SupportToClaimValue) PackValue() []byte {
 	return value
 }
 
-func SupportToClaimKeyPackPartialKey(key *SupportToClaimKey) func(int) []byte {
-	return func(fields int) []byte {
-		return SupportToClaimKeyPackPartial(key, fields)
-	}
-}
-
-func SupportToClaimKeyPackPartialfields(fields int) func(*SupportToClaimKey) []byte {
-	return func(u *SupportToClaimKey) []byte {
-		return SupportToClaimKeyPackPartial(u, fields)
-	}
-}
-
 func SupportToClaimKeyPackPartial(k *SupportToClaimKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2234,6 +2005,13 @@ type ClaimExpirationValue struct {
 	NormalizedName string `json:"normalized_name"`
 }
 
+func NewClaimExpirationKey(expiration uint32) *ClaimExpirationKey {
+	return &ClaimExpirationKey{
+		Prefix:     []byte{ClaimExpiration},
+		Expiration: expiration,
+	}
+}
+
 func (k *ClaimExpirationKey) PackKey() []byte {
 	prefixLen := 1
 	// b'>LLH'
@@ -2258,18 +2036,6 @@ func (v *ClaimExpirationValue) PackValue() []byte {
 	return value
 }
 
-func ClaimExpirationKeyPackPartialKey(key *ClaimExpirationKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ClaimExpirationKeyPackPartial(key, fields)
-	}
-}
-
-func ClaimExpirationKeyPackPartialfields(fields int) func(*ClaimExpirationKey) []byte {
-	return func(u *ClaimExpirationKey) []byte {
-		return ClaimExpirationKeyPackPartial(u, fields)
-	}
-}
-
 func ClaimExpirationKeyPackPartial(k *ClaimExpirationKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2377,18 +2143,6 @@ func (v *ClaimTakeoverValue) PackValue() []byte {
 	return value
 }
 
-func ClaimTakeoverKeyPackPartialKey(key *ClaimTakeoverKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ClaimTakeoverKeyPackPartial(key, fields)
-	}
-}
-
-func ClaimTakeoverKeyPackPartialfields(fields int) func(*ClaimTakeoverKey) []byte {
-	return func(u *ClaimTakeoverKey) []byte {
-		return ClaimTakeoverKeyPackPartial(u, fields)
-	}
-}
-
 func ClaimTakeoverKeyPackPartial(k *ClaimTakeoverKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2461,6 +2215,13 @@ type PendingActivationValue struct {
 	NormalizedName string `json:"normalized_name"`
 }
 
+func NewPendingActivationKey(height uint32) *PendingActivationKey {
+	return &PendingActivationKey{
+		Prefix: []byte{PendingActivation},
+		Height: height,
+	}
+}
+
 func (k *PendingActivationKey) PackKey() []byte {
 	prefixLen := 1
 	// b'>LBLH'
@@ -2486,18 +2247,6 @@ func (v *PendingActivationValue) PackValue() []byte {
 	return value
 }
 
-func PendingActivationKeyPackPartialKey(key *PendingActivationKey) func(int) []byte {
-	return func(fields int) []byte {
-		return PendingActivationKeyPackPartial(key, fields)
-	}
-}
-
-func PendingActivationKeyPackPartialfields(fields int) func(*PendingActivationKey) []byte {
-	return func(u *PendingActivationKey) []byte {
-		return PendingActivationKeyPackPartial(u, fields)
-	}
-}
-
 func PendingActivationKeyPackPartial(k *PendingActivationKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2610,18 +2359,6 @@ func (v *ActivationValue) PackValue() []byte {
 	return value
 }
 
-func ActivationKeyPackPartialKey(key *ActivationKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ActivationKeyPackPartial(key, fields)
-	}
-}
-
-func ActivationKeyPackPartialfields(fields int) func(*ActivationKey) []byte {
-	return func(u *ActivationKey) []byte {
-		return ActivationKeyPackPartial(u, fields)
-	}
-}
-
 func ActivationKeyPackPartial(k *ActivationKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2727,18 +2464,6 @@ func (v *ActiveAmountValue) PackValue() []byte {
 	return value
 }
 
-func ActiveAmountKeyPackPartialKey(key *ActiveAmountKey) func(int) []byte {
-	return func(fields int) []byte {
-		return ActiveAmountKeyPackPartial(key, fields)
-	}
-}
-
-func ActiveAmountKeyPackPartialfields(fields int) func(*ActiveAmountKey) []byte {
-	return func(u *ActiveAmountKey) []byte {
-		return ActiveAmountKeyPackPartial(u, fields)
-	}
-}
-
 func ActiveAmountKeyPackPartial(k *ActiveAmountKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2852,18 +2577,6 @@ func (v *EffectiveAmountValue) PackValue() []byte {
 	return value
 }
 
-func EffectiveAmountKeyPackPartialKey(key *EffectiveAmountKey) func(int) []byte {
-	return func(fields int) []byte {
-		return EffectiveAmountKeyPackPartial(key, fields)
-	}
-}
-
-func EffectiveAmountKeyPackPartialfields(fields int) func(*EffectiveAmountKey) []byte {
-	return func(u *EffectiveAmountKey) []byte {
-		return EffectiveAmountKeyPackPartial(u, fields)
-	}
-}
-
 func EffectiveAmountKeyPackPartial(k *EffectiveAmountKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -2966,18 +2679,6 @@ func (v *RepostValue) PackValue() []byte {
 	return value
 }
 
-func RepostKeyPackPartialKey(key *RepostKey) func(int) []byte {
-	return func(fields int) []byte {
-		return RepostKeyPackPartial(key, fields)
-	}
-}
-
-func RepostKeyPackPartialfields(fields int) func(*RepostKey) []byte {
-	return func(u *RepostKey) []byte {
-		return RepostKeyPackPartial(u, fields)
-	}
-}
-
 func RepostKeyPackPartial(k *RepostKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -3064,18 +2765,6 @@ func (v *RepostedValue) PackValue() []byte {
 	return value
 }
 
-func RepostedKeyPackPartialKey(key *RepostedKey) func(int) []byte {
-	return func(fields int) []byte {
-		return RepostedKeyPackPartial(key, fields)
-	}
-}
-
-func RepostedKeyPackPartialfields(fields int) func(*RepostedKey) []byte {
-	return func(u *RepostedKey) []byte {
-		return RepostedKeyPackPartial(u, fields)
-	}
-}
-
 func RepostedKeyPackPartial(k *RepostedKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -3170,6 +2859,13 @@ func (v *TouchedOrDeletedClaimValue) String() string {
 	)
 }
 
+func NewTouchedOrDeletedClaimKey(height int32) *TouchedOrDeletedClaimKey {
+	return &TouchedOrDeletedClaimKey{
+		Prefix: []byte{ClaimDiff},
+		Height: height,
+	}
+}
+
 func (k *TouchedOrDeletedClaimKey) PackKey() []byte {
 	prefixLen := 1
 	// b'>L'
@@ -3222,18 +2918,6 @@ func (v *TouchedOrDeletedClaimValue) PackValue() []byte {
 	return value
 }
 
-func TouchedOrDeletedClaimKeyPackPartialKey(key *TouchedOrDeletedClaimKey) func(int) []byte {
-	return func(fields int) []byte {
-		return TouchedOrDeletedClaimKeyPackPartial(key, fields)
-	}
-}
-
-func TouchedOrDeletedClaimPackPartialfields(fields int) func(*TouchedOrDeletedClaimKey) []byte {
-	return func(u *TouchedOrDeletedClaimKey) []byte {
-		return TouchedOrDeletedClaimKeyPackPartial(u, fields)
-	}
-}
-
 func TouchedOrDeletedClaimKeyPackPartial(k *TouchedOrDeletedClaimKey, fields int) []byte {
 	// Limit fields between 0 and number of fields, we always at least need
 	// the prefix, and we never need to iterate past the number of fields.
@@ -3325,18 +3009,6 @@ func (k *UTXOValue) PackValue() []byte {
 	return value
 }
 
-func UTXOKeyPackPartialKey(key *UTXOKey) func(int) []byte {
-	return func(fields int) []byte {
-		return UTXOKeyPackPartial(key, fields)
-	}
-}
-
-func UTXOKeyPackPartialfields(fields int) func(*UTXOKey) []byte {
-	return func(u *UTXOKey) []byte {
-		return UTXOKeyPackPartial(u, fields)
-	}
-}
-
 // UTXOKeyPackPartial packs a variable number of fields for a UTXOKey into
 // a byte array.
 func UTXOKeyPackPartial(k *UTXOKey, fields int) []byte {
@@ -3397,364 +3069,63 @@ func UTXOValueUnpack(value []byte) *UTXOValue {
 	}
 }
 
-// generic simulates a generic key packing / unpacking function for the prefixes
-func generic(voidstar interface{}, firstByte byte, function byte, functionName string) (interface{}, error) {
-	var data []byte
-	if function < 2 {
-		data = voidstar.([]byte)
-	}
-	switch uint16(firstByte) | uint16(function)<<8 {
-	case ClaimToSupport:
-		return ClaimToSupportKeyUnpack(data), nil
-	case ClaimToSupport | 1<<8:
-		return ClaimToSupportValueUnpack(data), nil
-	case ClaimToSupport | 2<<8:
-		return voidstar.(*ClaimToSupportKey).PackKey(), nil
-	case ClaimToSupport | 3<<8:
-		return voidstar.(*ClaimToSupportValue).PackValue(), nil
-	case ClaimToSupport | 4<<8:
-		return ClaimToSupportKeyPackPartialKey(voidstar.(*ClaimToSupportKey)), nil
-	case SupportToClaim:
-		return SupportToClaimKeyUnpack(data), nil
-	case SupportToClaim | 1<<8:
-		return SupportToClaimValueUnpack(data), nil
-	case SupportToClaim | 2<<8:
-		return voidstar.(*SupportToClaimKey).PackKey(), nil
-	case SupportToClaim | 3<<8:
-		return voidstar.(*SupportToClaimValue).PackValue(), nil
-	case SupportToClaim | 4<<8:
-		return SupportToClaimKeyPackPartialKey(voidstar.(*SupportToClaimKey)), nil
-	case ClaimToTXO:
-		return ClaimToTXOKeyUnpack(data), nil
-	case ClaimToTXO | 1<<8:
-		return ClaimToTXOValueUnpack(data), nil
-	case ClaimToTXO | 2<<8:
-		return voidstar.(*ClaimToTXOKey).PackKey(), nil
-	case ClaimToTXO | 3<<8:
-		return voidstar.(*ClaimToTXOValue).PackValue(), nil
-	case ClaimToTXO | 4<<8:
-		return ClaimToTXOKeyPackPartialKey(voidstar.(*ClaimToTXOKey)), nil
-	case TXOToClaim:
-		return TXOToClaimKeyUnpack(data), nil
-	case TXOToClaim | 1<<8:
-		return TXOToClaimValueUnpack(data), nil
-	case TXOToClaim | 2<<8:
-		return voidstar.(*TXOToClaimKey).PackKey(), nil
-	case TXOToClaim | 3<<8:
-		return voidstar.(*TXOToClaimValue).PackValue(), nil
-	case TXOToClaim | 4<<8:
-		return TXOToClaimKeyPackPartialKey(voidstar.(*TXOToClaimKey)), nil
-
-	case ClaimToChannel:
-		return ClaimToChannelKeyUnpack(data), nil
-	case ClaimToChannel | 1<<8:
-		return ClaimToChannelValueUnpack(data), nil
-	case ClaimToChannel | 2<<8:
-		return voidstar.(*ClaimToChannelKey).PackKey(), nil
-	case ClaimToChannel | 3<<8:
-		return voidstar.(*ClaimToChannelValue).PackValue(), nil
-	case ClaimToChannel | 4<<8:
-		return ClaimToChannelKeyPackPartialKey(voidstar.(*ClaimToChannelKey)), nil
-	case ChannelToClaim:
-		return ChannelToClaimKeyUnpack(data), nil
-	case ChannelToClaim | 1<<8:
-		return ChannelToClaimValueUnpack(data), nil
-	case ChannelToClaim | 2<<8:
-		return voidstar.(*ChannelToClaimKey).PackKey(), nil
-	case ChannelToClaim | 3<<8:
-		return voidstar.(*ChannelToClaimValue).PackValue(), nil
-	case ChannelToClaim | 4<<8:
-		return ChannelToClaimKeyPackPartialKey(voidstar.(*ChannelToClaimKey)), nil
-
-	case ClaimShortIdPrefix:
-		return ClaimShortIDKeyUnpack(data), nil
-	case ClaimShortIdPrefix | 1<<8:
-		return ClaimShortIDValueUnpack(data), nil
-	case ClaimShortIdPrefix | 2<<8:
-		return voidstar.(*ClaimShortIDKey).PackKey(), nil
-	case ClaimShortIdPrefix | 3<<8:
-		return voidstar.(*ClaimShortIDValue).PackValue(), nil
-	case ClaimShortIdPrefix | 4<<8:
-		return ClaimShortIDKeyPackPartialKey(voidstar.(*ClaimShortIDKey)), nil
-	case EffectiveAmount:
-		return EffectiveAmountKeyUnpack(data), nil
-	case EffectiveAmount | 1<<8:
-		return EffectiveAmountValueUnpack(data), nil
-	case EffectiveAmount | 2<<8:
-		return voidstar.(*EffectiveAmountKey).PackKey(), nil
-	case EffectiveAmount | 3<<8:
-		return voidstar.(*EffectiveAmountValue).PackValue(), nil
-	case EffectiveAmount | 4<<8:
-		return EffectiveAmountKeyPackPartialKey(voidstar.(*EffectiveAmountKey)), nil
-	case ClaimExpiration:
-		return ClaimExpirationKeyUnpack(data), nil
-	case ClaimExpiration | 1<<8:
-		return ClaimExpirationValueUnpack(data), nil
-	case ClaimExpiration | 2<<8:
-		return voidstar.(*ClaimExpirationKey).PackKey(), nil
-	case ClaimExpiration | 3<<8:
-		return voidstar.(*ClaimExpirationValue).PackValue(), nil
-	case ClaimExpiration | 4<<8:
-		return ClaimExpirationKeyPackPartialKey(voidstar.(*ClaimExpirationKey)), nil
-
-	case ClaimTakeover:
-		return ClaimTakeoverKeyUnpack(data), nil
-	case ClaimTakeover | 1<<8:
-		return ClaimTakeoverValueUnpack(data), nil
-	case ClaimTakeover | 2<<8:
-		return voidstar.(*ClaimTakeoverKey).PackKey(), nil
-	case ClaimTakeover | 3<<8:
-		return voidstar.(*ClaimTakeoverValue).PackValue(), nil
-	case ClaimTakeover | 4<<8:
-		return ClaimTakeoverKeyPackPartialKey(voidstar.(*ClaimTakeoverKey)), nil
-	case PendingActivation:
-		return PendingActivationKeyUnpack(data), nil
-	case PendingActivation | 1<<8:
-		return PendingActivationValueUnpack(data), nil
-	case PendingActivation | 2<<8:
-		return voidstar.(*PendingActivationKey).PackKey(), nil
-	case PendingActivation | 3<<8:
-		return voidstar.(*PendingActivationValue).PackValue(), nil
-	case PendingActivation | 4<<8:
-		return PendingActivationKeyPackPartialKey(voidstar.(*PendingActivationKey)), nil
-	case ActivatedClaimAndSupport:
-		return ActivationKeyUnpack(data), nil
-	case ActivatedClaimAndSupport | 1<<8:
-		return ActivationValueUnpack(data), nil
-	case ActivatedClaimAndSupport | 2<<8:
-		return voidstar.(*ActivationKey).PackKey(), nil
-	case ActivatedClaimAndSupport | 3<<8:
-		return voidstar.(*ActivationValue).PackValue(), nil
-	case ActivatedClaimAndSupport | 4<<8:
-		return ActivationKeyPackPartialKey(voidstar.(*ActivationKey)), nil
-	case ActiveAmount:
-		return ActiveAmountKeyUnpack(data), nil
-	case ActiveAmount | 1<<8:
-		return ActiveAmountValueUnpack(data), nil
-	case ActiveAmount | 2<<8:
-		return voidstar.(*ActiveAmountKey).PackKey(), nil
-	case ActiveAmount | 3<<8:
-		return voidstar.(*ActiveAmountValue).PackValue(), nil
-	case ActiveAmount | 4<<8:
-		return ActiveAmountKeyPackPartialKey(voidstar.(*ActiveAmountKey)), nil
-
-	case Repost:
-		return RepostKeyUnpack(data), nil
-	case Repost | 1<<8:
-		return RepostValueUnpack(data), nil
-	case Repost | 2<<8:
-		return voidstar.(*RepostKey).PackKey(), nil
-	case Repost | 3<<8:
-		return voidstar.(*RepostValue).PackValue(), nil
-	case Repost | 4<<8:
-		return RepostKeyPackPartialKey(voidstar.(*RepostKey)), nil
-	case RepostedClaim:
-		return RepostedKeyUnpack(data), nil
-	case RepostedClaim | 1<<8:
-		return RepostedValueUnpack(data), nil
-	case RepostedClaim | 2<<8:
-		return voidstar.(*RepostedKey).PackKey(), nil
-	case RepostedClaim | 3<<8:
-		return voidstar.(*RepostedValue).PackValue(), nil
-	case RepostedClaim | 4<<8:
-		return RepostedKeyPackPartialKey(voidstar.(*RepostedKey)), nil
-
-	case Undo:
-		return UndoKeyUnpack(data), nil
-	case Undo | 1<<8:
-		return UndoValueUnpack(data), nil
-	case Undo | 2<<8:
-		return voidstar.(*UndoKey).PackKey(), nil
-	case Undo | 3<<8:
-		return voidstar.(*UndoValue).PackValue(), nil
-	case Undo | 4<<8:
-		return UndoKeyPackPartialKey(voidstar.(*UndoKey)), nil
-	case ClaimDiff:
-		return TouchedOrDeletedClaimKeyUnpack(data), nil
-	case ClaimDiff | 1<<8:
-		return TouchedOrDeletedClaimValueUnpack(data), nil
-	case ClaimDiff | 2<<8:
-		return voidstar.(*TouchedOrDeletedClaimKey).PackKey(), nil
-	case ClaimDiff | 3<<8:
-		return voidstar.(*TouchedOrDeletedClaimValue).PackValue(), nil
-	case ClaimDiff | 4<<8:
-		return TouchedOrDeletedClaimKeyPackPartialKey(voidstar.(*TouchedOrDeletedClaimKey)), nil
-
-	case Tx:
-		return TxKeyUnpack(data), nil
-	case Tx | 1<<8:
-		return TxValueUnpack(data), nil
-	case Tx | 2<<8:
-		return voidstar.(*TxKey).PackKey(), nil
-	case Tx | 3<<8:
-		return voidstar.(*TxValue).PackValue(), nil
-	case Tx | 4<<8:
-		return TxKeyPackPartialKey(voidstar.(*TxKey)), nil
-	case BlockHash:
-		return BlockHashKeyUnpack(data), nil
-	case BlockHash | 1<<8:
-		return BlockHashValueUnpack(data), nil
-	case BlockHash | 2<<8:
-		return voidstar.(*BlockHashKey).PackKey(), nil
-	case BlockHash | 3<<8:
-		return voidstar.(*BlockHashValue).PackValue(), nil
-	case BlockHash | 4<<8:
-		return BlockHashKeyPackPartialKey(voidstar.(*BlockHashKey)), nil
-	case Header:
-		return BlockHeaderKeyUnpack(data), nil
-	case Header | 1<<8:
-		return BlockHeaderValueUnpack(data), nil
-	case Header | 2<<8:
-		return voidstar.(*BlockHeaderKey).PackKey(), nil
-	case Header | 3<<8:
-		return voidstar.(*BlockHeaderValue).PackValue(), nil
-	case Header | 4<<8:
-		return BlockHeaderKeyPackPartialKey(voidstar.(*BlockHeaderKey)), nil
-	case TxNum:
-		return TxNumKeyUnpack(data), nil
-	case TxNum | 1<<8:
-		return TxNumValueUnpack(data), nil
-	case TxNum | 2<<8:
-		return voidstar.(*TxNumKey).PackKey(), nil
-	case TxNum | 3<<8:
-		return voidstar.(*TxNumValue).PackValue(), nil
-	case TxNum | 4<<8:
-		return TxNumKeyPackPartialKey(voidstar.(*TxNumKey)), nil
-
-	case TxCount:
-		return TxCountKeyUnpack(data), nil
-	case TxCount | 1<<8:
-		return TxCountValueUnpack(data), nil
-	case TxCount | 2<<8:
-		return voidstar.(*TxCountKey).PackKey(), nil
-	case TxCount | 3<<8:
-		return voidstar.(*TxCountValue).PackValue(), nil
-	case TxCount | 4<<8:
-		return TxCountKeyPackPartialKey(voidstar.(*TxCountKey)), nil
-	case TxHash:
-		return TxHashKeyUnpack(data), nil
-	case TxHash | 1<<8:
-		return TxHashValueUnpack(data), nil
-	case TxHash | 2<<8:
-		return voidstar.(*TxHashKey).PackKey(), nil
-	case TxHash | 3<<8:
-		return voidstar.(*TxHashValue).PackValue(), nil
-	case TxHash | 4<<8:
-		return TxHashKeyPackPartialKey(voidstar.(*TxHashKey)), nil
-	case UTXO:
-		return UTXOKeyUnpack(data), nil
-	case UTXO | 1<<8:
-		return UTXOValueUnpack(data), nil
-	case UTXO | 2<<8:
-		return voidstar.(*UTXOKey).PackKey(), nil
-	case UTXO | 3<<8:
-		return voidstar.(*UTXOValue).PackValue(), nil
-	case UTXO | 4<<8:
-		return UTXOKeyPackPartialKey(voidstar.(*UTXOKey)), nil
-	case HashXUTXO:
-		return HashXUTXOKeyUnpack(data), nil
-	case HashXUTXO | 1<<8:
-		return HashXUTXOValueUnpack(data), nil
-	case HashXUTXO | 2<<8:
-		return voidstar.(*HashXUTXOKey).PackKey(), nil
-	case HashXUTXO | 3<<8:
-		return voidstar.(*HashXUTXOValue).PackValue(), nil
-	case HashXUTXO | 4<<8:
-		return HashXUTXOKeyPackPartialKey(voidstar.(*HashXUTXOKey)), nil
-	case HashXHistory:
-		return HashXHistoryKeyUnpack(data), nil
-	case HashXHistory | 1<<8:
-		return HashXHistoryValueUnpack(data), nil
-	case HashXHistory | 2<<8:
-		return voidstar.(*HashXHistoryKey).PackKey(), nil
-	case HashXHistory | 3<<8:
-		return voidstar.(*HashXHistoryValue).PackValue(), nil
-	case HashXHistory | 4<<8:
-		return HashXHistoryKeyPackPartialKey(voidstar.(*HashXHistoryKey)), nil
-	case DBState:
-		return DBStateKeyUnpack(data), nil
-	case DBState | 1<<8:
-		return DBStateValueUnpack(data), nil
-	case DBState | 2<<8:
-		return voidstar.(*DBStateKey).PackKey(), nil
-	case DBState | 3<<8:
-		return voidstar.(*DBStateValue).PackValue(), nil
-	case DBState | 4<<8:
-		return DBStateKeyPackPartialKey(voidstar.(*DBStateKey)), nil
-
-	case ChannelCount:
-		return ChannelCountKeyUnpack(data), nil
-	case ChannelCount | 1<<8:
-		return ChannelCountValueUnpack(data), nil
-	case ChannelCount | 2<<8:
-		return voidstar.(*ChannelCountKey).PackKey(), nil
-	case ChannelCount | 3<<8:
-		return voidstar.(*ChannelCountValue).PackValue(), nil
-	case ChannelCount | 4<<8:
-		return ChannelCountKeyPackPartialKey(voidstar.(*ChannelCountKey)), nil
-	case SupportAmount:
-		return SupportAmountKeyUnpack(data), nil
-	case SupportAmount | 1<<8:
-		return SupportAmountValueUnpack(data), nil
-	case SupportAmount | 2<<8:
-		return voidstar.(*SupportAmountKey).PackKey(), nil
-	case SupportAmount | 3<<8:
-		return voidstar.(*SupportAmountValue).PackValue(), nil
-	case SupportAmount | 4<<8:
-		return SupportAmountKeyPackPartialKey(voidstar.(*SupportAmountKey)), nil
-	case BlockTXs:
-		return BlockTxsKeyUnpack(data), nil
-	case BlockTXs | 1<<8:
-		return BlockTxsValueUnpack(data), nil
-	case BlockTXs | 2<<8:
-		return voidstar.(*BlockTxsKey).PackKey(), nil
-	case BlockTXs | 3<<8:
-		return voidstar.(*BlockTxsValue).PackValue(), nil
-	case BlockTXs | 4<<8:
-		return BlockTxsKeyPackPartialKey(voidstar.(*BlockTxsKey)), nil
-
-	}
-	return nil, fmt.Errorf("%s function for %v not implemented", functionName, firstByte)
-}
-
+// UnpackGenericKey unpacks a key of any registered prefix.
 func UnpackGenericKey(key []byte) (interface{}, error) {
 	if len(key) == 0 {
 		return nil, fmt.Errorf("key length zero")
 	}
-	return generic(key, key[0], 0, "unpack key")
+	codec, err := GetCodec(key[0])
+	if err != nil {
+		return nil, err
+	}
+	return codec.UnpackKey(key)
 }
 
+// UnpackGenericValue unpacks a value of any registered prefix, given its key.
 func UnpackGenericValue(key, value []byte) (interface{}, error) {
 	if len(key) == 0 {
 		return nil, fmt.Errorf("key length zero")
 	}
-	if len(value) == 0 {
-		return nil, fmt.Errorf("value length zero")
+	codec, err := GetCodec(key[0])
+	if err != nil {
+		return nil, err
 	}
-	return generic(value, key[0], 1, "unpack value")
+	return codec.UnpackValue(value)
 }
 
+// PackPartialGenericKey packs the prefix and the first fields fields of a key
+// of any registered prefix.
 func PackPartialGenericKey(prefix byte, key interface{}, fields int) ([]byte, error) {
 	if key == nil {
 		return nil, fmt.Errorf("key length zero")
 	}
-	genericRes, err := generic(key, prefix, 4, "pack partial key")
-	res := genericRes.(func(int) []byte)(fields)
-	return res, err
+	codec, err := GetCodec(prefix)
+	if err != nil {
+		return nil, err
+	}
+	return codec.PackPartialKey(key, fields)
 }
 
+// PackGenericKey packs a key of any registered prefix.
 func PackGenericKey(prefix byte, key interface{}) ([]byte, error) {
 	if key == nil {
 		return nil, fmt.Errorf("key length zero")
 	}
-	genericRes, err := generic(key, prefix, 2, "pack key")
-	return genericRes.([]byte), err
+	codec, err := GetCodec(prefix)
+	if err != nil {
+		return nil, err
+	}
+	return codec.PackKey(key)
 }
 
+// PackGenericValue packs a value of any registered prefix.
 func PackGenericValue(prefix byte, value interface{}) ([]byte, error) {
 	if value == nil {
 		return nil, fmt.Errorf("value length zero")
 	}
-	genericRes, err := generic(value, prefix, 3, "pack value")
-	return genericRes.([]byte), err
+	codec, err := GetCodec(prefix)
+	if err != nil {
+		return nil, err
+	}
+	return codec.PackValue(value)
 }
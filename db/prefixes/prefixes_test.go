@@ -2,6 +2,7 @@ package prefixes_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/csv"
 	"encoding/hex"
 	"fmt"
@@ -50,8 +51,12 @@ func testInit(filePath string) (*grocksdb.DB, [][]string, func(), *grocksdb.Colu
 	return db, records, toDefer, handle
 }
 
-func testGeneric(filePath string, prefix byte, numPartials int) func(*testing.T) {
+func testGeneric(filePath string, prefix byte) func(*testing.T) {
 	return func(t *testing.T) {
+		codec, err := prefixes.GetCodec(prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
 
 		wOpts := grocksdb.NewDefaultWriteOptions()
 		db, records, toDefer, handle := testInit(filePath)
@@ -69,26 +74,34 @@ func testGeneric(filePath string, prefix byte, numPartials int) func(*testing.T)
 			db.PutCF(wOpts, handle, key, val)
 		}
 		// test prefix
-		options := dbpkg.NewIterateOptions().WithPrefix([]byte{prefix}).WithIncludeValue(true)
+		options := dbpkg.NewIterateOptions().WithPrefix([]byte{prefix})
 		options = options.WithCfHandle(handle)
-		ch := dbpkg.IterCF(db, options)
+		it := dbpkg.NewIterator(context.Background(), db, options)
+		defer it.Close()
 		var i = 0
-		for kv := range ch {
-			// log.Println(kv.Key)
-			gotKey, err := prefixes.PackGenericKey(prefix, kv.Key)
+		for it.Next() {
+			key, err := codec.UnpackKey(it.Key())
+			if err != nil {
+				t.Fatal(err)
+			}
+			value, err := codec.UnpackValue(it.Value())
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotKey, err := codec.PackKey(key)
 			if err != nil {
 				log.Println(err)
 			}
 
-			for j := 1; j <= numPartials; j++ {
-				keyPartial, _ := prefixes.PackPartialGenericKey(prefix, kv.Key, j)
+			for j := 1; j <= len(codec.KeyFields()); j++ {
+				keyPartial, _ := codec.PackPartialKey(key, j)
 				// Check pack partial for sanity
 				if !bytes.HasPrefix(gotKey, keyPartial) {
 					t.Errorf("%+v should be prefix of %+v\n", keyPartial, gotKey)
 				}
 			}
 
-			got, err := prefixes.PackGenericValue(prefix, kv.Value)
+			got, err := codec.PackValue(value)
 			if err != nil {
 				log.Println(err)
 			}
@@ -108,6 +121,9 @@ func testGeneric(filePath string, prefix byte, numPartials int) func(*testing.T)
 			}
 			i++
 		}
+		if err := it.Err(); err != nil {
+			t.Fatal(err)
+		}
 
 		// Test start / stop
 		start, err := hex.DecodeString(records[0][0])
@@ -123,12 +139,17 @@ func testGeneric(filePath string, prefix byte, numPartials int) func(*testing.T)
 		if err != nil {
 			log.Println(err)
 		}
-		options2 := dbpkg.NewIterateOptions().WithStart(start).WithStop(stop).WithIncludeValue(true)
+		options2 := dbpkg.NewIterateOptions().WithStart(start).WithStop(stop)
 		options2 = options2.WithCfHandle(handle)
-		ch2 := dbpkg.IterCF(db, options2)
+		it2 := dbpkg.NewIterator(context.Background(), db, options2)
+		defer it2.Close()
 		i = 0
-		for kv := range ch2 {
-			got, err := prefixes.PackGenericValue(prefix, kv.Value)
+		for it2.Next() {
+			value, err := codec.UnpackValue(it2.Value())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := codec.PackValue(value)
 			if err != nil {
 				log.Println(err)
 			}
@@ -141,157 +162,193 @@ func testGeneric(filePath string, prefix byte, numPartials int) func(*testing.T)
 			}
 			i++
 		}
+		if err := it2.Err(); err != nil {
+			t.Fatal(err)
+		}
 	}
 }
 
 func TestSupportAmount(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.SupportAmount)
-	testGeneric(filePath, prefixes.SupportAmount, 1)(t)
+	testGeneric(filePath, prefixes.SupportAmount)(t)
 }
 
 func TestChannelCount(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ChannelCount)
-	testGeneric(filePath, prefixes.ChannelCount, 1)(t)
+	testGeneric(filePath, prefixes.ChannelCount)(t)
 }
 
 func TestDBState(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.DBState)
-	testGeneric(filePath, prefixes.DBState, 0)(t)
+	testGeneric(filePath, prefixes.DBState)(t)
 }
 
 func TestBlockTxs(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.BlockTXs)
-	testGeneric(filePath, prefixes.BlockTXs, 1)(t)
+	testGeneric(filePath, prefixes.BlockTXs)(t)
 }
 
 func TestTxCount(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.TxCount)
-	testGeneric(filePath, prefixes.TxCount, 1)(t)
+	testGeneric(filePath, prefixes.TxCount)(t)
 }
 
 func TestTxHash(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.TxHash)
-	testGeneric(filePath, prefixes.TxHash, 1)(t)
+	testGeneric(filePath, prefixes.TxHash)(t)
 }
 
 func TestTxNum(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.TxNum)
-	testGeneric(filePath, prefixes.TxNum, 1)(t)
+	testGeneric(filePath, prefixes.TxNum)(t)
 }
 
 func TestTx(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.Tx)
-	testGeneric(filePath, prefixes.Tx, 1)(t)
+	testGeneric(filePath, prefixes.Tx)(t)
 }
 
 func TestHashXHistory(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.HashXHistory)
-	testGeneric(filePath, prefixes.HashXHistory, 2)(t)
+	testGeneric(filePath, prefixes.HashXHistory)(t)
 }
 
 func TestUndo(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.Undo)
-	testGeneric(filePath, prefixes.Undo, 1)(t)
+	testGeneric(filePath, prefixes.Undo)(t)
 }
 
 func TestBlockHash(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.BlockHash)
-	testGeneric(filePath, prefixes.BlockHash, 1)(t)
+	testGeneric(filePath, prefixes.BlockHash)(t)
 }
 
 func TestBlockHeader(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.Header)
-	testGeneric(filePath, prefixes.Header, 1)(t)
+	testGeneric(filePath, prefixes.Header)(t)
 }
 
 func TestClaimToTXO(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ClaimToTXO)
-	testGeneric(filePath, prefixes.ClaimToTXO, 1)(t)
+	testGeneric(filePath, prefixes.ClaimToTXO)(t)
 }
 
 func TestTXOToClaim(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.TXOToClaim)
-	testGeneric(filePath, prefixes.TXOToClaim, 2)(t)
+	testGeneric(filePath, prefixes.TXOToClaim)(t)
 }
 
 func TestClaimShortID(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ClaimShortIdPrefix)
-	testGeneric(filePath, prefixes.ClaimShortIdPrefix, 3)(t)
+	testGeneric(filePath, prefixes.ClaimShortIdPrefix)(t)
 }
 
 func TestClaimToChannel(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ClaimToChannel)
-	testGeneric(filePath, prefixes.ClaimToChannel, 3)(t)
+	testGeneric(filePath, prefixes.ClaimToChannel)(t)
 }
 
 func TestChannelToClaim(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ChannelToClaim)
-	testGeneric(filePath, prefixes.ChannelToClaim, 4)(t)
+	testGeneric(filePath, prefixes.ChannelToClaim)(t)
 }
 
 func TestClaimToSupport(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ClaimToSupport)
-	testGeneric(filePath, prefixes.ClaimToSupport, 3)(t)
+	testGeneric(filePath, prefixes.ClaimToSupport)(t)
 }
 
 func TestSupportToClaim(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.SupportToClaim)
-	testGeneric(filePath, prefixes.SupportToClaim, 2)(t)
+	testGeneric(filePath, prefixes.SupportToClaim)(t)
 }
 
 func TestClaimExpiration(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ClaimExpiration)
-	testGeneric(filePath, prefixes.ClaimExpiration, 3)(t)
+	testGeneric(filePath, prefixes.ClaimExpiration)(t)
 }
 
 func TestClaimTakeover(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ClaimTakeover)
-	testGeneric(filePath, prefixes.ClaimTakeover, 1)(t)
+	testGeneric(filePath, prefixes.ClaimTakeover)(t)
 }
 
 func TestPendingActivation(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.PendingActivation)
-	testGeneric(filePath, prefixes.PendingActivation, 4)(t)
+	testGeneric(filePath, prefixes.PendingActivation)(t)
 }
 
 func TestActivated(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ActivatedClaimAndSupport)
-	testGeneric(filePath, prefixes.ActivatedClaimAndSupport, 3)(t)
+	testGeneric(filePath, prefixes.ActivatedClaimAndSupport)(t)
 }
 
 func TestActiveAmount(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ActiveAmount)
-	testGeneric(filePath, prefixes.ActiveAmount, 5)(t)
+	testGeneric(filePath, prefixes.ActiveAmount)(t)
 }
 
 func TestEffectiveAmount(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.EffectiveAmount)
-	testGeneric(filePath, prefixes.EffectiveAmount, 4)(t)
+	testGeneric(filePath, prefixes.EffectiveAmount)(t)
 }
 
 func TestRepost(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.Repost)
-	testGeneric(filePath, prefixes.Repost, 1)(t)
+	testGeneric(filePath, prefixes.Repost)(t)
 }
 
 func TestRepostedClaim(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.RepostedClaim)
-	testGeneric(filePath, prefixes.RepostedClaim, 3)(t)
+	testGeneric(filePath, prefixes.RepostedClaim)(t)
 }
 
 func TestClaimDiff(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.ClaimDiff)
-	testGeneric(filePath, prefixes.ClaimDiff, 1)(t)
+	testGeneric(filePath, prefixes.ClaimDiff)(t)
 }
 
 func TestUTXO(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.UTXO)
-	testGeneric(filePath, prefixes.UTXO, 1)(t)
+	testGeneric(filePath, prefixes.UTXO)(t)
 }
 
 func TestHashXUTXO(t *testing.T) {
 	filePath := fmt.Sprintf("../../testdata/%c.csv", prefixes.HashXUTXO)
-	testGeneric(filePath, prefixes.HashXUTXO, 3)(t)
+	testGeneric(filePath, prefixes.HashXUTXO)(t)
+}
+
+func TestRegistry(t *testing.T) {
+	if len(prefixes.Codecs()) != 30 {
+		t.Errorf("Expected 30 codecs, got %d", len(prefixes.Codecs()))
+	}
+	codec, err := prefixes.GetCodec(prefixes.ClaimToTXO)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if codec.Name() != "claim_to_txo" || len(codec.KeyFields()) != 1 {
+		t.Errorf("Expected claim_to_txo with 1 key field, got %s with %v", codec.Name(), codec.KeyFields())
+	}
+
+	key := prefixes.NewClaimToTXOKey(bytes.Repeat([]byte{1}, 20))
+	rawKey := prefixes.ClaimToTXOCodec.PackTypedKey(key)
+	if got := prefixes.ClaimToTXOCodec.UnpackTypedKey(rawKey); !bytes.Equal(got.ClaimHash, key.ClaimHash) {
+		t.Errorf("Expected %x, got %x", key.ClaimHash, got.ClaimHash)
+	}
+
+	// Unknown prefixes, the wrong types and truncated rows are errors.
+	if _, err := prefixes.GetCodec('!'); err == nil {
+		t.Error("Expected an error for an unknown prefix")
+	}
+	if _, err := prefixes.UnpackGenericKey([]byte("!abc")); err == nil {
+		t.Error("Expected an error for a key with an unknown prefix")
+	}
+	if _, err := codec.PackKey(prefixes.NewTxHashKey(1)); err == nil {
+		t.Error("Expected an error for a key of another prefix")
+	}
+	if _, err := codec.UnpackValue([]byte{0, 0, 0, 1}); err == nil {
+		t.Error("Expected an error for a truncated value")
+	}
 }
 
 func TestUTXOKey_String(t *testing.T) {
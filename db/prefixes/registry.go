@@ -0,0 +1,188 @@
+package prefixes
+
+// registry.go contains the registry of prefix codecs. Each prefix registers
+// a codec with its name, key and value types, pack / unpack functions and
+// key fields, and everything that needs to handle rows of any prefix
+// (iterators, csv dumpers, tests) looks the codec up by its prefix byte.
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Codec serializes the keys and values of a prefix without knowing their
+// types.
+type Codec interface {
+	// Prefix is the prefix byte, and the name of the column family.
+	Prefix() byte
+	// Name is the name of the prefix in the python hub.
+	Name() string
+	// KeyFields are the names of the fields of the key after the prefix, in
+	// the order they're packed.
+	KeyFields() []string
+	UnpackKey(key []byte) (interface{}, error)
+	UnpackValue(value []byte) (interface{}, error)
+	PackKey(key interface{}) ([]byte, error)
+	PackValue(value interface{}) ([]byte, error)
+	// PackPartialKey packs the prefix and the first fields fields of a key.
+	PackPartialKey(key interface{}, fields int) ([]byte, error)
+}
+
+// TypedCodec is the codec of a prefix with keys of type K and values of type
+// V.
+type TypedCodec[K, V any] struct {
+	prefix    byte
+	name      string
+	keyFields []string
+
+	unpackKey      func([]byte) K
+	unpackValue    func([]byte) V
+	packKey        func(K) []byte
+	packValue      func(V) []byte
+	packPartialKey func(K, int) []byte
+}
+
+// NewTypedCodec returns the codec of a prefix from its functions.
+func NewTypedCodec[K, V any](
+	prefix byte,
+	name string,
+	keyFields []string,
+	unpackKey func([]byte) K,
+	unpackValue func([]byte) V,
+	packKey func(K) []byte,
+	packValue func(V) []byte,
+	packPartialKey func(K, int) []byte,
+) *TypedCodec[K, V] {
+	return &TypedCodec[K, V]{
+		prefix:         prefix,
+		name:           name,
+		keyFields:      keyFields,
+		unpackKey:      unpackKey,
+		unpackValue:    unpackValue,
+		packKey:        packKey,
+		packValue:      packValue,
+		packPartialKey: packPartialKey,
+	}
+}
+
+func (c *TypedCodec[K, V]) Prefix() byte {
+	return c.prefix
+}
+
+func (c *TypedCodec[K, V]) Name() string {
+	return c.name
+}
+
+func (c *TypedCodec[K, V]) KeyFields() []string {
+	return c.keyFields
+}
+
+// UnpackTypedKey unpacks a key.
+func (c *TypedCodec[K, V]) UnpackTypedKey(key []byte) K {
+	return c.unpackKey(key)
+}
+
+// UnpackTypedValue unpacks a value.
+func (c *TypedCodec[K, V]) UnpackTypedValue(value []byte) V {
+	return c.unpackValue(value)
+}
+
+// PackTypedKey packs a key.
+func (c *TypedCodec[K, V]) PackTypedKey(key K) []byte {
+	return c.packKey(key)
+}
+
+// PackTypedValue packs a value.
+func (c *TypedCodec[K, V]) PackTypedValue(value V) []byte {
+	return c.packValue(value)
+}
+
+// PackTypedPartialKey packs the prefix and the first fields fields of a key.
+func (c *TypedCodec[K, V]) PackTypedPartialKey(key K, fields int) []byte {
+	return c.packPartialKey(key, fields)
+}
+
+func (c *TypedCodec[K, V]) UnpackKey(key []byte) (res interface{}, err error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("key length zero")
+	} else if key[0] != c.prefix {
+		return nil, fmt.Errorf("%s key has prefix %q, expected %q", c.name, key[0], c.prefix)
+	}
+	// The unpack functions don't check lengths, a truncated row panics.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unpack %s key %x: %v", c.name, key, r)
+		}
+	}()
+	return c.unpackKey(key), nil
+}
+
+func (c *TypedCodec[K, V]) UnpackValue(value []byte) (res interface{}, err error) {
+	if len(value) == 0 {
+		return nil, fmt.Errorf("value length zero")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unpack %s value %x: %v", c.name, value, r)
+		}
+	}()
+	return c.unpackValue(value), nil
+}
+
+func (c *TypedCodec[K, V]) PackKey(key interface{}) ([]byte, error) {
+	k, ok := key.(K)
+	if !ok {
+		return nil, fmt.Errorf("%s key has type %T, expected %T", c.name, key, k)
+	}
+	return c.packKey(k), nil
+}
+
+func (c *TypedCodec[K, V]) PackValue(value interface{}) ([]byte, error) {
+	v, ok := value.(V)
+	if !ok {
+		return nil, fmt.Errorf("%s value has type %T, expected %T", c.name, value, v)
+	}
+	return c.packValue(v), nil
+}
+
+func (c *TypedCodec[K, V]) PackPartialKey(key interface{}, fields int) ([]byte, error) {
+	k, ok := key.(K)
+	if !ok {
+		return nil, fmt.Errorf("%s key has type %T, expected %T", c.name, key, k)
+	}
+	return c.packPartialKey(k, fields), nil
+}
+
+var registry = make(map[byte]Codec)
+
+// Register adds the codec of a prefix to the registry, and returns it so it
+// can be kept in a typed variable. It panics if the prefix is already
+// registered.
+func Register[K, V any](codec *TypedCodec[K, V]) *TypedCodec[K, V] {
+	if other, ok := registry[codec.prefix]; ok {
+		panic(fmt.Sprintf("prefix %q registered for %s and %s", codec.prefix, other.Name(), codec.name))
+	}
+	registry[codec.prefix] = codec
+	return codec
+}
+
+// GetCodec returns the codec registered for a prefix.
+func GetCodec(prefix byte) (Codec, error) {
+	codec, ok := registry[prefix]
+	if !ok {
+		return nil, fmt.Errorf("unknown prefix %q (0x%02x)", prefix, prefix)
+	}
+	return codec, nil
+}
+
+// Codecs returns all the registered codecs, ordered by prefix.
+func Codecs() []Codec {
+	res := make([]Codec, 0, len(registry))
+	for _, codec := range registry {
+		res = append(res, codec)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Prefix() < res[j].Prefix()
+	})
+	return res
+}
@@ -38,4 +38,52 @@ var (
 		Name: "reorg_count",
 		Help: "Number of blockchain reorgs we have done.",
 	})
+	RateLimitedCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rate_limited_count",
+		Help: "Number of requests rejected by the rate limiter",
+	}, []string{"method"})
+	RateLimitCost = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "rate_limit_cost",
+		Help: "Total estimated cost of admitted requests",
+	}, []string{"method"})
+	RateLimitClients = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "rate_limit_clients",
+		Help: "Number of clients tracked by the rate limiter.",
+	})
+	RateLimitRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rate_limit_rate",
+		Help: "Configured rate limit in tokens per second",
+	}, []string{"client_type"})
+	RateLimitBurst = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rate_limit_burst",
+		Help: "Configured rate limit burst in tokens",
+	}, []string{"client_type"})
+	EsBreakerState = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "es_breaker_state",
+		Help: "State of the elasticsearch circuit breaker (0 closed, 1 half-open, 2 open).",
+	})
+	EsBreakerTrips = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "es_breaker_trips",
+		Help: "Number of times the elasticsearch circuit breaker has opened.",
+	})
+	DegradedSearches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "degraded_searches",
+		Help: "Number of searches answered while elasticsearch is down, by source",
+	}, []string{"source"})
+	ResolveCacheCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "resolve_cache_count",
+		Help: "Number of resolve cache lookups, by result",
+	}, []string{"result"})
+	ResolveCacheInvalidations = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "resolve_cache_invalidations",
+		Help: "Number of resolve cache entries invalidated by new blocks.",
+	})
+	SignatureAudits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signature_audits",
+		Help: "Number of claim signatures verified by the signature audit.",
+	})
+	SignatureAuditMismatches = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signature_audit_mismatches",
+		Help: "Number of audited claim signatures that didn't match the stored flag.",
+	})
 )
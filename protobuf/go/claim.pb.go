@@ -4762,10 +4762,10 @@ var file_claim_proto_rawDesc = []byte{
 	0x12, 0x09, 0x0a, 0x04, 0x52, 0x38, 0x38, 0x32, 0x10, 0x8d, 0x04, 0x12, 0x09, 0x0a, 0x04, 0x52,
 	0x37, 0x37, 0x32, 0x10, 0x8e, 0x04, 0x12, 0x09, 0x0a, 0x04, 0x52, 0x37, 0x37, 0x36, 0x10, 0x8f,
 	0x04, 0x12, 0x09, 0x0a, 0x04, 0x52, 0x37, 0x39, 0x38, 0x10, 0x90, 0x04, 0x12, 0x09, 0x0a, 0x04,
-	0x52, 0x38, 0x37, 0x36, 0x10, 0x91, 0x04, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x62, 0x72, 0x79, 0x69, 0x6f, 0x2f, 0x68, 0x75, 0x62,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x2f, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x38, 0x37, 0x36, 0x10, 0x91, 0x04, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x62, 0x72, 0x79, 0x69, 0x6f, 0x2f, 0x68, 0x65, 0x72,
+	0x61, 0x6c, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x67, 0x6f, 0x2f,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -32,7 +32,19 @@ type HubClient interface {
 	Broadcast(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*UInt32Value, error)
 	Height(ctx context.Context, in *EmptyMessage, opts ...grpc.CallOption) (*UInt32Value, error)
 	HeightSubscribe(ctx context.Context, in *UInt32Value, opts ...grpc.CallOption) (Hub_HeightSubscribeClient, error)
-	Resolve(ctx context.Context, in *StringArray, opts ...grpc.CallOption) (*Outputs, error)
+	Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*Outputs, error)
+	Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error)
+	Related(ctx context.Context, in *RelatedRequest, opts ...grpc.CallOption) (*Outputs, error)
+	ClaimHistory(ctx context.Context, in *ClaimHistoryRequest, opts ...grpc.CallOption) (*ClaimHistoryResponse, error)
+	NameBids(ctx context.Context, in *NameBidsRequest, opts ...grpc.CallOption) (*NameBidsResponse, error)
+	ListSupports(ctx context.Context, in *ListSupportsRequest, opts ...grpc.CallOption) (*ListSupportsResponse, error)
+	SupportClaim(ctx context.Context, in *SupportClaimRequest, opts ...grpc.CallOption) (*SupportClaimResponse, error)
+	ChannelClaims(ctx context.Context, in *ChannelClaimsRequest, opts ...grpc.CallOption) (*Outputs, error)
+	ListReposts(ctx context.Context, in *ListRepostsRequest, opts ...grpc.CallOption) (*Outputs, error)
+	Expirations(ctx context.Context, in *ExpirationsRequest, opts ...grpc.CallOption) (*ExpirationsResponse, error)
+	PredictTakeover(ctx context.Context, in *PredictTakeoverRequest, opts ...grpc.CallOption) (*TakeoverPrediction, error)
+	UpcomingTakeovers(ctx context.Context, in *UpcomingTakeoversRequest, opts ...grpc.CallOption) (*UpcomingTakeoversResponse, error)
+	VerifyClaimSignature(ctx context.Context, in *VerifyClaimSignatureRequest, opts ...grpc.CallOption) (*VerifyClaimSignatureResponse, error)
 }
 
 type hubClient struct {
@@ -156,7 +168,7 @@ func (x *hubHeightSubscribeClient) Recv() (*UInt32Value, error) {
 	return m, nil
 }
 
-func (c *hubClient) Resolve(ctx context.Context, in *StringArray, opts ...grpc.CallOption) (*Outputs, error) {
+func (c *hubClient) Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*Outputs, error) {
 	out := new(Outputs)
 	err := c.cc.Invoke(ctx, "/pb.Hub/Resolve", in, out, opts...)
 	if err != nil {
@@ -165,6 +177,114 @@ func (c *hubClient) Resolve(ctx context.Context, in *StringArray, opts ...grpc.C
 	return out, nil
 }
 
+func (c *hubClient) Suggest(ctx context.Context, in *SuggestRequest, opts ...grpc.CallOption) (*SuggestResponse, error) {
+	out := new(SuggestResponse)
+	err := c.cc.Invoke(ctx, "/pb.Hub/Suggest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) Related(ctx context.Context, in *RelatedRequest, opts ...grpc.CallOption) (*Outputs, error) {
+	out := new(Outputs)
+	err := c.cc.Invoke(ctx, "/pb.Hub/Related", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) ClaimHistory(ctx context.Context, in *ClaimHistoryRequest, opts ...grpc.CallOption) (*ClaimHistoryResponse, error) {
+	out := new(ClaimHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.Hub/ClaimHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) NameBids(ctx context.Context, in *NameBidsRequest, opts ...grpc.CallOption) (*NameBidsResponse, error) {
+	out := new(NameBidsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Hub/NameBids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) ListSupports(ctx context.Context, in *ListSupportsRequest, opts ...grpc.CallOption) (*ListSupportsResponse, error) {
+	out := new(ListSupportsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Hub/ListSupports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) SupportClaim(ctx context.Context, in *SupportClaimRequest, opts ...grpc.CallOption) (*SupportClaimResponse, error) {
+	out := new(SupportClaimResponse)
+	err := c.cc.Invoke(ctx, "/pb.Hub/SupportClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) ChannelClaims(ctx context.Context, in *ChannelClaimsRequest, opts ...grpc.CallOption) (*Outputs, error) {
+	out := new(Outputs)
+	err := c.cc.Invoke(ctx, "/pb.Hub/ChannelClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) ListReposts(ctx context.Context, in *ListRepostsRequest, opts ...grpc.CallOption) (*Outputs, error) {
+	out := new(Outputs)
+	err := c.cc.Invoke(ctx, "/pb.Hub/ListReposts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) Expirations(ctx context.Context, in *ExpirationsRequest, opts ...grpc.CallOption) (*ExpirationsResponse, error) {
+	out := new(ExpirationsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Hub/Expirations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) PredictTakeover(ctx context.Context, in *PredictTakeoverRequest, opts ...grpc.CallOption) (*TakeoverPrediction, error) {
+	out := new(TakeoverPrediction)
+	err := c.cc.Invoke(ctx, "/pb.Hub/PredictTakeover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) UpcomingTakeovers(ctx context.Context, in *UpcomingTakeoversRequest, opts ...grpc.CallOption) (*UpcomingTakeoversResponse, error) {
+	out := new(UpcomingTakeoversResponse)
+	err := c.cc.Invoke(ctx, "/pb.Hub/UpcomingTakeovers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubClient) VerifyClaimSignature(ctx context.Context, in *VerifyClaimSignatureRequest, opts ...grpc.CallOption) (*VerifyClaimSignatureResponse, error) {
+	out := new(VerifyClaimSignatureResponse)
+	err := c.cc.Invoke(ctx, "/pb.Hub/VerifyClaimSignature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HubServer is the server API for Hub service.
 // All implementations must embed UnimplementedHubServer
 // for forward compatibility
@@ -179,7 +299,19 @@ type HubServer interface {
 	Broadcast(context.Context, *EmptyMessage) (*UInt32Value, error)
 	Height(context.Context, *EmptyMessage) (*UInt32Value, error)
 	HeightSubscribe(*UInt32Value, Hub_HeightSubscribeServer) error
-	Resolve(context.Context, *StringArray) (*Outputs, error)
+	Resolve(context.Context, *ResolveRequest) (*Outputs, error)
+	Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error)
+	Related(context.Context, *RelatedRequest) (*Outputs, error)
+	ClaimHistory(context.Context, *ClaimHistoryRequest) (*ClaimHistoryResponse, error)
+	NameBids(context.Context, *NameBidsRequest) (*NameBidsResponse, error)
+	ListSupports(context.Context, *ListSupportsRequest) (*ListSupportsResponse, error)
+	SupportClaim(context.Context, *SupportClaimRequest) (*SupportClaimResponse, error)
+	ChannelClaims(context.Context, *ChannelClaimsRequest) (*Outputs, error)
+	ListReposts(context.Context, *ListRepostsRequest) (*Outputs, error)
+	Expirations(context.Context, *ExpirationsRequest) (*ExpirationsResponse, error)
+	PredictTakeover(context.Context, *PredictTakeoverRequest) (*TakeoverPrediction, error)
+	UpcomingTakeovers(context.Context, *UpcomingTakeoversRequest) (*UpcomingTakeoversResponse, error)
+	VerifyClaimSignature(context.Context, *VerifyClaimSignatureRequest) (*VerifyClaimSignatureResponse, error)
 	mustEmbedUnimplementedHubServer()
 }
 
@@ -217,9 +349,45 @@ func (UnimplementedHubServer) Height(context.Context, *EmptyMessage) (*UInt32Val
 func (UnimplementedHubServer) HeightSubscribe(*UInt32Value, Hub_HeightSubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method HeightSubscribe not implemented")
 }
-func (UnimplementedHubServer) Resolve(context.Context, *StringArray) (*Outputs, error) {
+func (UnimplementedHubServer) Resolve(context.Context, *ResolveRequest) (*Outputs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
 }
+func (UnimplementedHubServer) Suggest(context.Context, *SuggestRequest) (*SuggestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suggest not implemented")
+}
+func (UnimplementedHubServer) Related(context.Context, *RelatedRequest) (*Outputs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Related not implemented")
+}
+func (UnimplementedHubServer) ClaimHistory(context.Context, *ClaimHistoryRequest) (*ClaimHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHistory not implemented")
+}
+func (UnimplementedHubServer) NameBids(context.Context, *NameBidsRequest) (*NameBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NameBids not implemented")
+}
+func (UnimplementedHubServer) ListSupports(context.Context, *ListSupportsRequest) (*ListSupportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupports not implemented")
+}
+func (UnimplementedHubServer) SupportClaim(context.Context, *SupportClaimRequest) (*SupportClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportClaim not implemented")
+}
+func (UnimplementedHubServer) ChannelClaims(context.Context, *ChannelClaimsRequest) (*Outputs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelClaims not implemented")
+}
+func (UnimplementedHubServer) ListReposts(context.Context, *ListRepostsRequest) (*Outputs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReposts not implemented")
+}
+func (UnimplementedHubServer) Expirations(context.Context, *ExpirationsRequest) (*ExpirationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expirations not implemented")
+}
+func (UnimplementedHubServer) PredictTakeover(context.Context, *PredictTakeoverRequest) (*TakeoverPrediction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PredictTakeover not implemented")
+}
+func (UnimplementedHubServer) UpcomingTakeovers(context.Context, *UpcomingTakeoversRequest) (*UpcomingTakeoversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingTakeovers not implemented")
+}
+func (UnimplementedHubServer) VerifyClaimSignature(context.Context, *VerifyClaimSignatureRequest) (*VerifyClaimSignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyClaimSignature not implemented")
+}
 func (UnimplementedHubServer) mustEmbedUnimplementedHubServer() {}
 
 // UnsafeHubServer may be embedded to opt out of forward compatibility for this service.
@@ -417,7 +585,7 @@ func (x *hubHeightSubscribeServer) Send(m *UInt32Value) error {
 }
 
 func _Hub_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StringArray)
+	in := new(ResolveRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -429,7 +597,223 @@ func _Hub_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interfa
 		FullMethod: "/pb.Hub/Resolve",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HubServer).Resolve(ctx, req.(*StringArray))
+		return srv.(HubServer).Resolve(ctx, req.(*ResolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_Suggest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).Suggest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/Suggest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).Suggest(ctx, req.(*SuggestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_Related_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).Related(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/Related",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).Related(ctx, req.(*RelatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_ClaimHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).ClaimHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/ClaimHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).ClaimHistory(ctx, req.(*ClaimHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_NameBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NameBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).NameBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/NameBids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).NameBids(ctx, req.(*NameBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_ListSupports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).ListSupports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/ListSupports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).ListSupports(ctx, req.(*ListSupportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_SupportClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupportClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).SupportClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/SupportClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).SupportClaim(ctx, req.(*SupportClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_ChannelClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).ChannelClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/ChannelClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).ChannelClaims(ctx, req.(*ChannelClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_ListReposts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).ListReposts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/ListReposts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).ListReposts(ctx, req.(*ListRepostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_Expirations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpirationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).Expirations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/Expirations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).Expirations(ctx, req.(*ExpirationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_PredictTakeover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PredictTakeoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).PredictTakeover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/PredictTakeover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).PredictTakeover(ctx, req.(*PredictTakeoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_UpcomingTakeovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpcomingTakeoversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).UpcomingTakeovers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/UpcomingTakeovers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).UpcomingTakeovers(ctx, req.(*UpcomingTakeoversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Hub_VerifyClaimSignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyClaimSignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubServer).VerifyClaimSignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Hub/VerifyClaimSignature",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubServer).VerifyClaimSignature(ctx, req.(*VerifyClaimSignatureRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -481,6 +865,54 @@ var Hub_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Resolve",
 			Handler:    _Hub_Resolve_Handler,
 		},
+		{
+			MethodName: "Suggest",
+			Handler:    _Hub_Suggest_Handler,
+		},
+		{
+			MethodName: "Related",
+			Handler:    _Hub_Related_Handler,
+		},
+		{
+			MethodName: "ClaimHistory",
+			Handler:    _Hub_ClaimHistory_Handler,
+		},
+		{
+			MethodName: "NameBids",
+			Handler:    _Hub_NameBids_Handler,
+		},
+		{
+			MethodName: "ListSupports",
+			Handler:    _Hub_ListSupports_Handler,
+		},
+		{
+			MethodName: "SupportClaim",
+			Handler:    _Hub_SupportClaim_Handler,
+		},
+		{
+			MethodName: "ChannelClaims",
+			Handler:    _Hub_ChannelClaims_Handler,
+		},
+		{
+			MethodName: "ListReposts",
+			Handler:    _Hub_ListReposts_Handler,
+		},
+		{
+			MethodName: "Expirations",
+			Handler:    _Hub_Expirations_Handler,
+		},
+		{
+			MethodName: "PredictTakeover",
+			Handler:    _Hub_PredictTakeover_Handler,
+		},
+		{
+			MethodName: "UpcomingTakeovers",
+			Handler:    _Hub_UpcomingTakeovers_Handler,
+		},
+		{
+			MethodName: "VerifyClaimSignature",
+			Handler:    _Hub_VerifyClaimSignature_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
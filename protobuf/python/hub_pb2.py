@@ -15,7 +15,7 @@ _sym_db = _symbol_database.Default()
 import result_pb2 as result__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\thub.proto\x12\x02pb\x1a\x0cresult.proto\"\x0e\n\x0c\x45mptyMessage\".\n\rServerMessage\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\t\x12\x0c\n\x04port\x18\x02 \x01(\t\"N\n\x0cHelloMessage\x12\x0c\n\x04port\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\x12\"\n\x07servers\x18\x03 \x03(\x0b\x32\x11.pb.ServerMessage\"0\n\x0fInvertibleField\x12\x0e\n\x06invert\x18\x01 \x01(\x08\x12\r\n\x05value\x18\x02 \x03(\t\"\x1c\n\x0bStringValue\x12\r\n\x05value\x18\x01 \x01(\t\"\x1c\n\x0bStringArray\x12\r\n\x05value\x18\x01 \x03(\t\"\x1a\n\tBoolValue\x12\r\n\x05value\x18\x01 \x01(\x08\"\x1c\n\x0bUInt32Value\x12\r\n\x05value\x18\x01 \x01(\r\"j\n\nRangeField\x12\x1d\n\x02op\x18\x01 \x01(\x0e\x32\x11.pb.RangeField.Op\x12\r\n\x05value\x18\x02 \x03(\x05\".\n\x02Op\x12\x06\n\x02\x45Q\x10\x00\x12\x07\n\x03LTE\x10\x01\x12\x07\n\x03GTE\x10\x02\x12\x06\n\x02LT\x10\x03\x12\x06\n\x02GT\x10\x04\"\xfd\x0b\n\rSearchRequest\x12%\n\x08\x63laim_id\x18\x01 \x01(\x0b\x32\x13.pb.InvertibleField\x12\'\n\nchannel_id\x18\x02 \x01(\x0b\x32\x13.pb.InvertibleField\x12\x0c\n\x04text\x18\x03 \x01(\t\x12\r\n\x05limit\x18\x04 \x01(\x05\x12\x10\n\x08order_by\x18\x05 \x03(\t\x12\x0e\n\x06offset\x18\x06 \x01(\r\x12\x16\n\x0eis_controlling\x18\x07 \x01(\x08\x12\x1d\n\x15last_take_over_height\x18\x08 \x01(\t\x12\x12\n\nclaim_name\x18\t \x01(\t\x12\x17\n\x0fnormalized_name\x18\n \x01(\t\x12#\n\x0btx_position\x18\x0b \x03(\x0b\x32\x0e.pb.RangeField\x12\x1e\n\x06\x61mount\x18\x0c \x03(\x0b\x32\x0e.pb.RangeField\x12!\n\ttimestamp\x18\r \x03(\x0b\x32\x0e.pb.RangeField\x12*\n\x12\x63reation_timestamp\x18\x0e \x03(\x0b\x32\x0e.pb.RangeField\x12\x1e\n\x06height\x18\x0f \x03(\x0b\x32\x0e.pb.RangeField\x12\'\n\x0f\x63reation_height\x18\x10 \x03(\x0b\x32\x0e.pb.RangeField\x12)\n\x11\x61\x63tivation_height\x18\x11 \x03(\x0b\x32\x0e.pb.RangeField\x12)\n\x11\x65xpiration_height\x18\x12 \x03(\x0b\x32\x0e.pb.RangeField\x12$\n\x0crelease_time\x18\x13 \x03(\x0b\x32\x0e.pb.RangeField\x12\x11\n\tshort_url\x18\x14 \x01(\t\x12\x15\n\rcanonical_url\x18\x15 \x01(\t\x12\r\n\x05title\x18\x16 \x01(\t\x12\x0e\n\x06\x61uthor\x18\x17 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x18 \x01(\t\x12\x12\n\nclaim_type\x18\x19 \x03(\t\x12$\n\x0crepost_count\x18\x1a \x03(\x0b\x32\x0e.pb.RangeField\x12\x13\n\x0bstream_type\x18\x1b \x03(\t\x12\x12\n\nmedia_type\x18\x1c \x03(\t\x12\"\n\nfee_amount\x18\x1d \x03(\x0b\x32\x0e.pb.RangeField\x12\x14\n\x0c\x66\x65\x65_currency\x18\x1e \x01(\t\x12 \n\x08\x64uration\x18\x1f \x03(\x0b\x32\x0e.pb.RangeField\x12\x19\n\x11reposted_claim_id\x18  \x01(\t\x12#\n\x0b\x63\x65nsor_type\x18! \x03(\x0b\x32\x0e.pb.RangeField\x12\x19\n\x11\x63laims_in_channel\x18\" \x01(\t\x12)\n\x12is_signature_valid\x18$ \x01(\x0b\x32\r.pb.BoolValue\x12(\n\x10\x65\x66\x66\x65\x63tive_amount\x18% \x03(\x0b\x32\x0e.pb.RangeField\x12&\n\x0esupport_amount\x18& \x03(\x0b\x32\x0e.pb.RangeField\x12&\n\x0etrending_score\x18\' \x03(\x0b\x32\x0e.pb.RangeField\x12\r\n\x05tx_id\x18+ \x01(\t\x12 \n\x07tx_nout\x18, \x01(\x0b\x32\x0f.pb.UInt32Value\x12\x11\n\tsignature\x18- \x01(\t\x12\x18\n\x10signature_digest\x18. \x01(\t\x12\x18\n\x10public_key_bytes\x18/ \x01(\t\x12\x15\n\rpublic_key_id\x18\x30 \x01(\t\x12\x10\n\x08\x61ny_tags\x18\x31 \x03(\t\x12\x10\n\x08\x61ll_tags\x18\x32 \x03(\t\x12\x10\n\x08not_tags\x18\x33 \x03(\t\x12\x1d\n\x15has_channel_signature\x18\x34 \x01(\x08\x12!\n\nhas_source\x18\x35 \x01(\x0b\x32\r.pb.BoolValue\x12 \n\x18limit_claims_per_channel\x18\x36 \x01(\x05\x12\x15\n\rany_languages\x18\x37 \x03(\t\x12\x15\n\rall_languages\x18\x38 \x03(\t\x12\x19\n\x11remove_duplicates\x18\x39 \x01(\x08\x12\x11\n\tno_totals\x18: \x01(\x08\x32\x9b\x04\n\x03Hub\x12*\n\x06Search\x12\x11.pb.SearchRequest\x1a\x0b.pb.Outputs\"\x00\x12+\n\x04Ping\x12\x10.pb.EmptyMessage\x1a\x0f.pb.StringValue\"\x00\x12-\n\x05Hello\x12\x10.pb.HelloMessage\x1a\x10.pb.HelloMessage\"\x00\x12/\n\x07\x41\x64\x64Peer\x12\x11.pb.ServerMessage\x1a\x0f.pb.StringValue\"\x00\x12\x35\n\rPeerSubscribe\x12\x11.pb.ServerMessage\x1a\x0f.pb.StringValue\"\x00\x12.\n\x07Version\x12\x10.pb.EmptyMessage\x1a\x0f.pb.StringValue\"\x00\x12/\n\x08\x46\x65\x61tures\x12\x10.pb.EmptyMessage\x1a\x0f.pb.StringValue\"\x00\x12\x30\n\tBroadcast\x12\x10.pb.EmptyMessage\x1a\x0f.pb.UInt32Value\"\x00\x12-\n\x06Height\x12\x10.pb.EmptyMessage\x1a\x0f.pb.UInt32Value\"\x00\x12\x37\n\x0fHeightSubscribe\x12\x0f.pb.UInt32Value\x1a\x0f.pb.UInt32Value\"\x00\x30\x01\x12)\n\x07Resolve\x12\x0f.pb.StringArray\x1a\x0b.pb.Outputs\"\x00\x42&Z$github.com/lbryio/herald/protobuf/go/pbb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\thub.proto\x12\x02pb\x1a\x0cresult.proto\"\x0e\n\x0c\x45mptyMessage\".\n\rServerMessage\x12\x0f\n\x07\x61\x64\x64ress\x18\x01 \x01(\t\x12\x0c\n\x04port\x18\x02 \x01(\t\"N\n\x0cHelloMessage\x12\x0c\n\x04port\x18\x01 \x01(\t\x12\x0c\n\x04host\x18\x02 \x01(\t\x12\"\n\x07servers\x18\x03 \x03(\x0b\x32\x11.pb.ServerMessage\"0\n\x0fInvertibleField\x12\x0e\n\x06invert\x18\x01 \x01(\x08\x12\r\n\x05value\x18\x02 \x03(\t\"\x1c\n\x0bStringValue\x12\r\n\x05value\x18\x01 \x01(\t\"\x1c\n\x0bStringArray\x12\r\n\x05value\x18\x01 \x03(\t\"\x1a\n\tBoolValue\x12\r\n\x05value\x18\x01 \x01(\x08\"\x1c\n\x0bUInt32Value\x12\r\n\x05value\x18\x01 \x01(\r\"j\n\nRangeField\x12\x1d\n\x02op\x18\x01 \x01(\x0e\x32\x11.pb.RangeField.Op\x12\r\n\x05value\x18\x02 \x03(\x05\".\n\x02Op\x12\x06\n\x02\x45Q\x10\x00\x12\x07\n\x03LTE\x10\x01\x12\x07\n\x03GTE\x10\x02\x12\x06\n\x02LT\x10\x03\x12\x06\n\x02GT\x10\x04\"\xd4\x0c\n\rSearchRequest\x12%\n\x08\x63laim_id\x18\x01 \x01(\x0b\x32\x13.pb.InvertibleField\x12\'\n\nchannel_id\x18\x02 \x01(\x0b\x32\x13.pb.InvertibleField\x12\x0c\n\x04text\x18\x03 \x01(\t\x12\r\n\x05limit\x18\x04 \x01(\x05\x12\x10\n\x08order_by\x18\x05 \x03(\t\x12\x0e\n\x06offset\x18\x06 \x01(\r\x12\x16\n\x0eis_controlling\x18\x07 \x01(\x08\x12\x1d\n\x15last_take_over_height\x18\x08 \x01(\t\x12\x12\n\nclaim_name\x18\t \x01(\t\x12\x17\n\x0fnormalized_name\x18\n \x01(\t\x12#\n\x0btx_position\x18\x0b \x03(\x0b\x32\x0e.pb.RangeField\x12\x1e\n\x06\x61mount\x18\x0c \x03(\x0b\x32\x0e.pb.RangeField\x12!\n\ttimestamp\x18\r \x03(\x0b\x32\x0e.pb.RangeField\x12*\n\x12\x63reation_timestamp\x18\x0e \x03(\x0b\x32\x0e.pb.RangeField\x12\x1e\n\x06height\x18\x0f \x03(\x0b\x32\x0e.pb.RangeField\x12\'\n\x0f\x63reation_height\x18\x10 \x03(\x0b\x32\x0e.pb.RangeField\x12)\n\x11\x61\x63tivation_height\x18\x11 \x03(\x0b\x32\x0e.pb.RangeField\x12)\n\x11\x65xpiration_height\x18\x12 \x03(\x0b\x32\x0e.pb.RangeField\x12$\n\x0crelease_time\x18\x13 \x03(\x0b\x32\x0e.pb.RangeField\x12\x11\n\tshort_url\x18\x14 \x01(\t\x12\x15\n\rcanonical_url\x18\x15 \x01(\t\x12\r\n\x05title\x18\x16 \x01(\t\x12\x0e\n\x06\x61uthor\x18\x17 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x18 \x01(\t\x12\x12\n\nclaim_type\x18\x19 \x03(\t\x12$\n\x0crepost_count\x18\x1a \x03(\x0b\x32\x0e.pb.RangeField\x12\x13\n\x0bstream_type\x18\x1b \x03(\t\x12\x12\n\nmedia_type\x18\x1c \x03(\t\x12\"\n\nfee_amount\x18\x1d \x03(\x0b\x32\x0e.pb.RangeField\x12\x14\n\x0c\x66\x65\x65_currency\x18\x1e \x01(\t\x12 \n\x08\x64uration\x18\x1f \x03(\x0b\x32\x0e.pb.RangeField\x12\x19\n\x11reposted_claim_id\x18  \x01(\t\x12#\n\x0b\x63\x65nsor_type\x18! \x03(\x0b\x32\x0e.pb.RangeField\x12\x19\n\x11\x63laims_in_channel\x18\" \x01(\t\x12)\n\x12is_signature_valid\x18$ \x01(\x0b\x32\r.pb.BoolValue\x12(\n\x10\x65\x66\x66\x65\x63tive_amount\x18% \x03(\x0b\x32\x0e.pb.RangeField\x12&\n\x0esupport_amount\x18& \x03(\x0b\x32\x0e.pb.RangeField\x12&\n\x0etrending_score\x18\' \x03(\x0b\x32\x0e.pb.RangeField\x12\r\n\x05tx_id\x18+ \x01(\t\x12 \n\x07tx_nout\x18, \x01(\x0b\x32\x0f.pb.UInt32Value\x12\x11\n\tsignature\x18- \x01(\t\x12\x18\n\x10signature_digest\x18. \x01(\t\x12\x18\n\x10public_key_bytes\x18/ \x01(\t\x12\x15\n\rpublic_key_id\x18\x30 \x01(\t\x12\x10\n\x08\x61ny_tags\x18\x31 \x03(\t\x12\x10\n\x08\x61ll_tags\x18\x32 \x03(\t\x12\x10\n\x08not_tags\x18\x33 \x03(\t\x12\x1d\n\x15has_channel_signature\x18\x34 \x01(\x08\x12!\n\nhas_source\x18\x35 \x01(\x0b\x32\r.pb.BoolValue\x12 \n\x18limit_claims_per_channel\x18\x36 \x01(\x05\x12\x15\n\rany_languages\x18\x37 \x03(\t\x12\x15\n\rall_languages\x18\x38 \x03(\t\x12\x19\n\x11remove_duplicates\x18\x39 \x01(\x08\x12\x11\n\tno_totals\x18: \x01(\x08\x12\x0f\n\x07sd_hash\x18; \x01(\t\x12\x17\n\x0franking_profile\x18< \x01(\t\x12\r\n\x05index\x18= \x03(\t\x12\x1c\n\x14include_claim_values\x18> \x01(\x08\"E\n\x0eSuggestRequest\x12\x0e\n\x06prefix\x18\x01 \x01(\t\x12\r\n\x05limit\x18\x02 \x01(\x05\x12\x14\n\x0cinclude_tags\x18\x03 \x01(\x08\"\x92\x01\n\nSuggestion\x12!\n\x04type\x18\x01 \x01(\x0e\x32\x13.pb.Suggestion.Type\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\x10\n\x08\x63laim_id\x18\x03 \x01(\t\x12\x18\n\x10\x65\x66\x66\x65\x63tive_amount\x18\x04 \x01(\x04\"\'\n\x04Type\x12\t\n\x05\x43LAIM\x10\x00\x12\x0b\n\x07\x43HANNEL\x10\x01\x12\x07\n\x03TAG\x10\x02\"6\n\x0fSuggestResponse\x12#\n\x0bsuggestions\x18\x01 \x03(\x0b\x32\x0e.pb.Suggestion\"\x9e\x01\n\x0eResolveRequest\x12\r\n\x05value\x18\x01 \x03(\t\x12\x0e\n\x06height\x18\x02 \x01(\r\x12\x1a\n\x12\x65xpand_collections\x18\x03 \x01(\x08\x12\x19\n\x11\x63ollection_offset\x18\x04 \x01(\r\x12\x18\n\x10\x63ollection_limit\x18\x05 \x01(\r\x12\x1c\n\x14include_claim_values\x18\x06 \x01(\x08\"\x81\x01\n\x0eRelatedRequest\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\x12\r\n\x05limit\x18\x02 \x01(\x05\x12\x0e\n\x06offset\x18\x03 \x01(\r\x12 \n\x18limit_claims_per_channel\x18\x04 \x01(\x05\x12\x1c\n\x14include_claim_values\x18\x05 \x01(\x08\"\'\n\x13\x43laimHistoryRequest\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\"\xd0\x01\n\x11\x43laimHistoryEntry\x12(\n\x04type\x18\x01 \x01(\x0e\x32\x1a.pb.ClaimHistoryEntry.Type\x12\x0f\n\x07tx_hash\x18\x02 \x01(\x0c\x12\x0c\n\x04nout\x18\x03 \x01(\r\x12\x0e\n\x06height\x18\x04 \x01(\r\x12\x0e\n\x06\x61mount\x18\x05 \x01(\x04\x12\x12\n\nchannel_id\x18\x06 \x01(\t\x12\x11\n\tsignature\x18\x07 \x01(\x0c\"+\n\x04Type\x12\n\n\x06\x43REATE\x10\x00\x12\n\n\x06UPDATE\x10\x01\x12\x0b\n\x07\x41\x42\x41NDON\x10\x02\">\n\x14\x43laimHistoryResponse\x12&\n\x07\x65ntries\x18\x01 \x03(\x0b\x32\x15.pb.ClaimHistoryEntry\"\x1f\n\x0fNameBidsRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x87\x01\n\x07NameBid\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\x12\x0f\n\x07tx_hash\x18\x02 \x01(\x0c\x12\x0c\n\x04nout\x18\x03 \x01(\r\x12\x18\n\x10\x65\x66\x66\x65\x63tive_amount\x18\x04 \x01(\x04\x12\x19\n\x11\x61\x63tivation_height\x18\x05 \x01(\r\x12\x16\n\x0eis_controlling\x18\x06 \x01(\x08\"\x83\x01\n\x11PendingActivation\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\x12\x0f\n\x07tx_hash\x18\x02 \x01(\x0c\x12\x0c\n\x04nout\x18\x03 \x01(\r\x12\x0e\n\x06\x61mount\x18\x04 \x01(\x04\x12\x19\n\x11\x61\x63tivation_height\x18\x05 \x01(\r\x12\x12\n\nis_support\x18\x06 \x01(\x08\"\xa5\x01\n\x10NameBidsResponse\x12\x17\n\x0fnormalized_name\x18\x01 \x01(\t\x12\x1c\n\x14\x63ontrolling_claim_id\x18\x02 \x01(\t\x12\x17\n\x0ftakeover_height\x18\x03 \x01(\r\x12\x19\n\x04\x62ids\x18\x04 \x03(\x0b\x32\x0b.pb.NameBid\x12&\n\x07pending\x18\x05 \x03(\x0b\x32\x15.pb.PendingActivation\"X\n\x13ListSupportsRequest\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\x12\x10\n\x08order_by\x18\x02 \x01(\t\x12\x0e\n\x06offset\x18\x03 \x01(\r\x12\r\n\x05limit\x18\x04 \x01(\r\"c\n\x07Support\x12\x0f\n\x07tx_hash\x18\x01 \x01(\x0c\x12\x0c\n\x04nout\x18\x02 \x01(\r\x12\x0e\n\x06height\x18\x03 \x01(\r\x12\x0e\n\x06\x61mount\x18\x04 \x01(\x04\x12\x19\n\x11\x61\x63tivation_height\x18\x05 \x01(\r\"D\n\x14ListSupportsResponse\x12\x1d\n\x08supports\x18\x01 \x03(\x0b\x32\x0b.pb.Support\x12\r\n\x05total\x18\x02 \x01(\r\"4\n\x13SupportClaimRequest\x12\x0f\n\x07tx_hash\x18\x01 \x01(\x0c\x12\x0c\n\x04nout\x18\x02 \x01(\r\"(\n\x14SupportClaimResponse\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\"]\n\x14\x43hannelClaimsRequest\x12\x12\n\nchannel_id\x18\x01 \x01(\t\x12\x12\n\nclaim_type\x18\x02 \x03(\t\x12\x0e\n\x06offset\x18\x03 \x01(\r\x12\r\n\x05limit\x18\x04 \x01(\r\"E\n\x12ListRepostsRequest\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\x12\x0e\n\x06offset\x18\x02 \x01(\r\x12\r\n\x05limit\x18\x03 \x01(\r\"o\n\x12\x45xpirationsRequest\x12\x12\n\nmin_height\x18\x01 \x01(\r\x12\x12\n\nmax_height\x18\x02 \x01(\r\x12\x12\n\nchannel_id\x18\x03 \x01(\t\x12\x0e\n\x06offset\x18\x04 \x01(\r\x12\r\n\x05limit\x18\x05 \x01(\r\"\xa3\x01\n\x0f\x43laimExpiration\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\x12\x17\n\x0fnormalized_name\x18\x02 \x01(\t\x12\x0f\n\x07tx_hash\x18\x03 \x01(\x0c\x12\x0c\n\x04nout\x18\x04 \x01(\r\x12\x0e\n\x06height\x18\x05 \x01(\r\x12\x19\n\x11\x65xpiration_height\x18\x06 \x01(\r\x12\x1b\n\x13\x62locks_until_expiry\x18\x07 \x01(\r\":\n\x13\x45xpirationsResponse\x12#\n\x06\x63laims\x18\x01 \x03(\x0b\x32\x13.pb.ClaimExpiration\"&\n\x16PredictTakeoverRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\"\xc0\x01\n\x12TakeoverPrediction\x12\x17\n\x0fnormalized_name\x18\x01 \x01(\t\x12\x1c\n\x14\x63ontrolling_claim_id\x18\x02 \x01(\t\x12\x17\n\x0ftakeover_height\x18\x03 \x01(\r\x12\x0e\n\x06height\x18\x04 \x01(\r\x12\x10\n\x08\x63laim_id\x18\x05 \x01(\t\x12\x18\n\x10\x65\x66\x66\x65\x63tive_amount\x18\x06 \x01(\x04\x12\x1e\n\x16next_activation_height\x18\x07 \x01(\r\"*\n\x18UpcomingTakeoversRequest\x12\x0e\n\x06\x62locks\x18\x01 \x01(\r\"F\n\x19UpcomingTakeoversResponse\x12)\n\ttakeovers\x18\x01 \x03(\x0b\x32\x16.pb.TakeoverPrediction\"/\n\x1bVerifyClaimSignatureRequest\x12\x10\n\x08\x63laim_id\x18\x01 \x01(\t\"g\n\x1cVerifyClaimSignatureResponse\x12\x0e\n\x06signed\x18\x01 \x01(\x08\x12\x12\n\nchannel_id\x18\x02 \x01(\t\x12\r\n\x05valid\x18\x03 \x01(\x08\x12\x14\n\x0cstored_valid\x18\x04 \x01(\x08\x32\xb6\n\n\x03Hub\x12*\n\x06Search\x12\x11.pb.SearchRequest\x1a\x0b.pb.Outputs\"\x00\x12+\n\x04Ping\x12\x10.pb.EmptyMessage\x1a\x0f.pb.StringValue\"\x00\x12-\n\x05Hello\x12\x10.pb.HelloMessage\x1a\x10.pb.HelloMessage\"\x00\x12/\n\x07\x41\x64\x64Peer\x12\x11.pb.ServerMessage\x1a\x0f.pb.StringValue\"\x00\x12\x35\n\rPeerSubscribe\x12\x11.pb.ServerMessage\x1a\x0f.pb.StringValue\"\x00\x12.\n\x07Version\x12\x10.pb.EmptyMessage\x1a\x0f.pb.StringValue\"\x00\x12/\n\x08\x46\x65\x61tures\x12\x10.pb.EmptyMessage\x1a\x0f.pb.StringValue\"\x00\x12\x30\n\tBroadcast\x12\x10.pb.EmptyMessage\x1a\x0f.pb.UInt32Value\"\x00\x12-\n\x06Height\x12\x10.pb.EmptyMessage\x1a\x0f.pb.UInt32Value\"\x00\x12\x37\n\x0fHeightSubscribe\x12\x0f.pb.UInt32Value\x1a\x0f.pb.UInt32Value\"\x00\x30\x01\x12,\n\x07Resolve\x12\x12.pb.ResolveRequest\x1a\x0b.pb.Outputs\"\x00\x12\x34\n\x07Suggest\x12\x12.pb.SuggestRequest\x1a\x13.pb.SuggestResponse\"\x00\x12,\n\x07Related\x12\x12.pb.RelatedRequest\x1a\x0b.pb.Outputs\"\x00\x12\x43\n\x0c\x43laimHistory\x12\x17.pb.ClaimHistoryRequest\x1a\x18.pb.ClaimHistoryResponse\"\x00\x12\x37\n\x08NameBids\x12\x13.pb.NameBidsRequest\x1a\x14.pb.NameBidsResponse\"\x00\x12\x43\n\x0cListSupports\x12\x17.pb.ListSupportsRequest\x1a\x18.pb.ListSupportsResponse\"\x00\x12\x43\n\x0cSupportClaim\x12\x17.pb.SupportClaimRequest\x1a\x18.pb.SupportClaimResponse\"\x00\x12\x38\n\rChannelClaims\x12\x18.pb.ChannelClaimsRequest\x1a\x0b.pb.Outputs\"\x00\x12\x34\n\x0bListReposts\x12\x16.pb.ListRepostsRequest\x1a\x0b.pb.Outputs\"\x00\x12@\n\x0b\x45xpirations\x12\x16.pb.ExpirationsRequest\x1a\x17.pb.ExpirationsResponse\"\x00\x12G\n\x0fPredictTakeover\x12\x1a.pb.PredictTakeoverRequest\x1a\x16.pb.TakeoverPrediction\"\x00\x12R\n\x11UpcomingTakeovers\x12\x1c.pb.UpcomingTakeoversRequest\x1a\x1d.pb.UpcomingTakeoversResponse\"\x00\x12[\n\x14VerifyClaimSignature\x12\x1f.pb.VerifyClaimSignatureRequest\x1a .pb.VerifyClaimSignatureResponse\"\x00\x42)Z\'github.com/lbryio/herald/protobuf/go/pbb\x06proto3')
 
 
 
@@ -29,7 +29,37 @@ _BOOLVALUE = DESCRIPTOR.message_types_by_name['BoolValue']
 _UINT32VALUE = DESCRIPTOR.message_types_by_name['UInt32Value']
 _RANGEFIELD = DESCRIPTOR.message_types_by_name['RangeField']
 _SEARCHREQUEST = DESCRIPTOR.message_types_by_name['SearchRequest']
+_SUGGESTREQUEST = DESCRIPTOR.message_types_by_name['SuggestRequest']
+_SUGGESTION = DESCRIPTOR.message_types_by_name['Suggestion']
+_SUGGESTRESPONSE = DESCRIPTOR.message_types_by_name['SuggestResponse']
+_RESOLVEREQUEST = DESCRIPTOR.message_types_by_name['ResolveRequest']
+_RELATEDREQUEST = DESCRIPTOR.message_types_by_name['RelatedRequest']
+_CLAIMHISTORYREQUEST = DESCRIPTOR.message_types_by_name['ClaimHistoryRequest']
+_CLAIMHISTORYENTRY = DESCRIPTOR.message_types_by_name['ClaimHistoryEntry']
+_CLAIMHISTORYRESPONSE = DESCRIPTOR.message_types_by_name['ClaimHistoryResponse']
+_NAMEBIDSREQUEST = DESCRIPTOR.message_types_by_name['NameBidsRequest']
+_NAMEBID = DESCRIPTOR.message_types_by_name['NameBid']
+_PENDINGACTIVATION = DESCRIPTOR.message_types_by_name['PendingActivation']
+_NAMEBIDSRESPONSE = DESCRIPTOR.message_types_by_name['NameBidsResponse']
+_LISTSUPPORTSREQUEST = DESCRIPTOR.message_types_by_name['ListSupportsRequest']
+_SUPPORT = DESCRIPTOR.message_types_by_name['Support']
+_LISTSUPPORTSRESPONSE = DESCRIPTOR.message_types_by_name['ListSupportsResponse']
+_SUPPORTCLAIMREQUEST = DESCRIPTOR.message_types_by_name['SupportClaimRequest']
+_SUPPORTCLAIMRESPONSE = DESCRIPTOR.message_types_by_name['SupportClaimResponse']
+_CHANNELCLAIMSREQUEST = DESCRIPTOR.message_types_by_name['ChannelClaimsRequest']
+_LISTREPOSTSREQUEST = DESCRIPTOR.message_types_by_name['ListRepostsRequest']
+_EXPIRATIONSREQUEST = DESCRIPTOR.message_types_by_name['ExpirationsRequest']
+_CLAIMEXPIRATION = DESCRIPTOR.message_types_by_name['ClaimExpiration']
+_EXPIRATIONSRESPONSE = DESCRIPTOR.message_types_by_name['ExpirationsResponse']
+_PREDICTTAKEOVERREQUEST = DESCRIPTOR.message_types_by_name['PredictTakeoverRequest']
+_TAKEOVERPREDICTION = DESCRIPTOR.message_types_by_name['TakeoverPrediction']
+_UPCOMINGTAKEOVERSREQUEST = DESCRIPTOR.message_types_by_name['UpcomingTakeoversRequest']
+_UPCOMINGTAKEOVERSRESPONSE = DESCRIPTOR.message_types_by_name['UpcomingTakeoversResponse']
+_VERIFYCLAIMSIGNATUREREQUEST = DESCRIPTOR.message_types_by_name['VerifyClaimSignatureRequest']
+_VERIFYCLAIMSIGNATURERESPONSE = DESCRIPTOR.message_types_by_name['VerifyClaimSignatureResponse']
 _RANGEFIELD_OP = _RANGEFIELD.enum_types_by_name['Op']
+_SUGGESTION_TYPE = _SUGGESTION.enum_types_by_name['Type']
+_CLAIMHISTORYENTRY_TYPE = _CLAIMHISTORYENTRY.enum_types_by_name['Type']
 EmptyMessage = _reflection.GeneratedProtocolMessageType('EmptyMessage', (_message.Message,), {
   'DESCRIPTOR' : _EMPTYMESSAGE,
   '__module__' : 'hub_pb2'
@@ -100,11 +130,207 @@ SearchRequest = _reflection.GeneratedProtocolMessageType('SearchRequest', (_mess
   })
 _sym_db.RegisterMessage(SearchRequest)
 
+SuggestRequest = _reflection.GeneratedProtocolMessageType('SuggestRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SUGGESTREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.SuggestRequest)
+  })
+_sym_db.RegisterMessage(SuggestRequest)
+
+Suggestion = _reflection.GeneratedProtocolMessageType('Suggestion', (_message.Message,), {
+  'DESCRIPTOR' : _SUGGESTION,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.Suggestion)
+  })
+_sym_db.RegisterMessage(Suggestion)
+
+SuggestResponse = _reflection.GeneratedProtocolMessageType('SuggestResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SUGGESTRESPONSE,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.SuggestResponse)
+  })
+_sym_db.RegisterMessage(SuggestResponse)
+
+ResolveRequest = _reflection.GeneratedProtocolMessageType('ResolveRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RESOLVEREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ResolveRequest)
+  })
+_sym_db.RegisterMessage(ResolveRequest)
+
+RelatedRequest = _reflection.GeneratedProtocolMessageType('RelatedRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RELATEDREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.RelatedRequest)
+  })
+_sym_db.RegisterMessage(RelatedRequest)
+
+ClaimHistoryRequest = _reflection.GeneratedProtocolMessageType('ClaimHistoryRequest', (_message.Message,), {
+  'DESCRIPTOR' : _CLAIMHISTORYREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ClaimHistoryRequest)
+  })
+_sym_db.RegisterMessage(ClaimHistoryRequest)
+
+ClaimHistoryEntry = _reflection.GeneratedProtocolMessageType('ClaimHistoryEntry', (_message.Message,), {
+  'DESCRIPTOR' : _CLAIMHISTORYENTRY,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ClaimHistoryEntry)
+  })
+_sym_db.RegisterMessage(ClaimHistoryEntry)
+
+ClaimHistoryResponse = _reflection.GeneratedProtocolMessageType('ClaimHistoryResponse', (_message.Message,), {
+  'DESCRIPTOR' : _CLAIMHISTORYRESPONSE,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ClaimHistoryResponse)
+  })
+_sym_db.RegisterMessage(ClaimHistoryResponse)
+
+NameBidsRequest = _reflection.GeneratedProtocolMessageType('NameBidsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _NAMEBIDSREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.NameBidsRequest)
+  })
+_sym_db.RegisterMessage(NameBidsRequest)
+
+NameBid = _reflection.GeneratedProtocolMessageType('NameBid', (_message.Message,), {
+  'DESCRIPTOR' : _NAMEBID,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.NameBid)
+  })
+_sym_db.RegisterMessage(NameBid)
+
+PendingActivation = _reflection.GeneratedProtocolMessageType('PendingActivation', (_message.Message,), {
+  'DESCRIPTOR' : _PENDINGACTIVATION,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.PendingActivation)
+  })
+_sym_db.RegisterMessage(PendingActivation)
+
+NameBidsResponse = _reflection.GeneratedProtocolMessageType('NameBidsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _NAMEBIDSRESPONSE,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.NameBidsResponse)
+  })
+_sym_db.RegisterMessage(NameBidsResponse)
+
+ListSupportsRequest = _reflection.GeneratedProtocolMessageType('ListSupportsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTSUPPORTSREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ListSupportsRequest)
+  })
+_sym_db.RegisterMessage(ListSupportsRequest)
+
+Support = _reflection.GeneratedProtocolMessageType('Support', (_message.Message,), {
+  'DESCRIPTOR' : _SUPPORT,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.Support)
+  })
+_sym_db.RegisterMessage(Support)
+
+ListSupportsResponse = _reflection.GeneratedProtocolMessageType('ListSupportsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _LISTSUPPORTSRESPONSE,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ListSupportsResponse)
+  })
+_sym_db.RegisterMessage(ListSupportsResponse)
+
+SupportClaimRequest = _reflection.GeneratedProtocolMessageType('SupportClaimRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SUPPORTCLAIMREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.SupportClaimRequest)
+  })
+_sym_db.RegisterMessage(SupportClaimRequest)
+
+SupportClaimResponse = _reflection.GeneratedProtocolMessageType('SupportClaimResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SUPPORTCLAIMRESPONSE,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.SupportClaimResponse)
+  })
+_sym_db.RegisterMessage(SupportClaimResponse)
+
+ChannelClaimsRequest = _reflection.GeneratedProtocolMessageType('ChannelClaimsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _CHANNELCLAIMSREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ChannelClaimsRequest)
+  })
+_sym_db.RegisterMessage(ChannelClaimsRequest)
+
+ListRepostsRequest = _reflection.GeneratedProtocolMessageType('ListRepostsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _LISTREPOSTSREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ListRepostsRequest)
+  })
+_sym_db.RegisterMessage(ListRepostsRequest)
+
+ExpirationsRequest = _reflection.GeneratedProtocolMessageType('ExpirationsRequest', (_message.Message,), {
+  'DESCRIPTOR' : _EXPIRATIONSREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ExpirationsRequest)
+  })
+_sym_db.RegisterMessage(ExpirationsRequest)
+
+ClaimExpiration = _reflection.GeneratedProtocolMessageType('ClaimExpiration', (_message.Message,), {
+  'DESCRIPTOR' : _CLAIMEXPIRATION,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ClaimExpiration)
+  })
+_sym_db.RegisterMessage(ClaimExpiration)
+
+ExpirationsResponse = _reflection.GeneratedProtocolMessageType('ExpirationsResponse', (_message.Message,), {
+  'DESCRIPTOR' : _EXPIRATIONSRESPONSE,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.ExpirationsResponse)
+  })
+_sym_db.RegisterMessage(ExpirationsResponse)
+
+PredictTakeoverRequest = _reflection.GeneratedProtocolMessageType('PredictTakeoverRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PREDICTTAKEOVERREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.PredictTakeoverRequest)
+  })
+_sym_db.RegisterMessage(PredictTakeoverRequest)
+
+TakeoverPrediction = _reflection.GeneratedProtocolMessageType('TakeoverPrediction', (_message.Message,), {
+  'DESCRIPTOR' : _TAKEOVERPREDICTION,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.TakeoverPrediction)
+  })
+_sym_db.RegisterMessage(TakeoverPrediction)
+
+UpcomingTakeoversRequest = _reflection.GeneratedProtocolMessageType('UpcomingTakeoversRequest', (_message.Message,), {
+  'DESCRIPTOR' : _UPCOMINGTAKEOVERSREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.UpcomingTakeoversRequest)
+  })
+_sym_db.RegisterMessage(UpcomingTakeoversRequest)
+
+UpcomingTakeoversResponse = _reflection.GeneratedProtocolMessageType('UpcomingTakeoversResponse', (_message.Message,), {
+  'DESCRIPTOR' : _UPCOMINGTAKEOVERSRESPONSE,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.UpcomingTakeoversResponse)
+  })
+_sym_db.RegisterMessage(UpcomingTakeoversResponse)
+
+VerifyClaimSignatureRequest = _reflection.GeneratedProtocolMessageType('VerifyClaimSignatureRequest', (_message.Message,), {
+  'DESCRIPTOR' : _VERIFYCLAIMSIGNATUREREQUEST,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.VerifyClaimSignatureRequest)
+  })
+_sym_db.RegisterMessage(VerifyClaimSignatureRequest)
+
+VerifyClaimSignatureResponse = _reflection.GeneratedProtocolMessageType('VerifyClaimSignatureResponse', (_message.Message,), {
+  'DESCRIPTOR' : _VERIFYCLAIMSIGNATURERESPONSE,
+  '__module__' : 'hub_pb2'
+  # @@protoc_insertion_point(class_scope:pb.VerifyClaimSignatureResponse)
+  })
+_sym_db.RegisterMessage(VerifyClaimSignatureResponse)
+
 _HUB = DESCRIPTOR.services_by_name['Hub']
 if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
-  DESCRIPTOR._serialized_options = b'Z$github.com/lbryio/herald/protobuf/go/pb'
+  DESCRIPTOR._serialized_options = b'Z\'github.com/lbryio/herald/protobuf/go/pb'
   _EMPTYMESSAGE._serialized_start=31
   _EMPTYMESSAGE._serialized_end=45
   _SERVERMESSAGE._serialized_start=47
@@ -126,7 +352,67 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _RANGEFIELD_OP._serialized_start=403
   _RANGEFIELD_OP._serialized_end=449
   _SEARCHREQUEST._serialized_start=452
-  _SEARCHREQUEST._serialized_end=1985
-  _HUB._serialized_start=1988
-  _HUB._serialized_end=2527
+  _SEARCHREQUEST._serialized_end=2072
+  _SUGGESTREQUEST._serialized_start=2074
+  _SUGGESTREQUEST._serialized_end=2143
+  _SUGGESTION._serialized_start=2146
+  _SUGGESTION._serialized_end=2292
+  _SUGGESTION_TYPE._serialized_start=2253
+  _SUGGESTION_TYPE._serialized_end=2292
+  _SUGGESTRESPONSE._serialized_start=2294
+  _SUGGESTRESPONSE._serialized_end=2348
+  _RESOLVEREQUEST._serialized_start=2351
+  _RESOLVEREQUEST._serialized_end=2509
+  _RELATEDREQUEST._serialized_start=2512
+  _RELATEDREQUEST._serialized_end=2641
+  _CLAIMHISTORYREQUEST._serialized_start=2643
+  _CLAIMHISTORYREQUEST._serialized_end=2682
+  _CLAIMHISTORYENTRY._serialized_start=2685
+  _CLAIMHISTORYENTRY._serialized_end=2893
+  _CLAIMHISTORYENTRY_TYPE._serialized_start=2850
+  _CLAIMHISTORYENTRY_TYPE._serialized_end=2893
+  _CLAIMHISTORYRESPONSE._serialized_start=2895
+  _CLAIMHISTORYRESPONSE._serialized_end=2957
+  _NAMEBIDSREQUEST._serialized_start=2959
+  _NAMEBIDSREQUEST._serialized_end=2990
+  _NAMEBID._serialized_start=2993
+  _NAMEBID._serialized_end=3128
+  _PENDINGACTIVATION._serialized_start=3131
+  _PENDINGACTIVATION._serialized_end=3262
+  _NAMEBIDSRESPONSE._serialized_start=3265
+  _NAMEBIDSRESPONSE._serialized_end=3430
+  _LISTSUPPORTSREQUEST._serialized_start=3432
+  _LISTSUPPORTSREQUEST._serialized_end=3520
+  _SUPPORT._serialized_start=3522
+  _SUPPORT._serialized_end=3621
+  _LISTSUPPORTSRESPONSE._serialized_start=3623
+  _LISTSUPPORTSRESPONSE._serialized_end=3691
+  _SUPPORTCLAIMREQUEST._serialized_start=3693
+  _SUPPORTCLAIMREQUEST._serialized_end=3745
+  _SUPPORTCLAIMRESPONSE._serialized_start=3747
+  _SUPPORTCLAIMRESPONSE._serialized_end=3787
+  _CHANNELCLAIMSREQUEST._serialized_start=3789
+  _CHANNELCLAIMSREQUEST._serialized_end=3882
+  _LISTREPOSTSREQUEST._serialized_start=3884
+  _LISTREPOSTSREQUEST._serialized_end=3953
+  _EXPIRATIONSREQUEST._serialized_start=3955
+  _EXPIRATIONSREQUEST._serialized_end=4066
+  _CLAIMEXPIRATION._serialized_start=4069
+  _CLAIMEXPIRATION._serialized_end=4232
+  _EXPIRATIONSRESPONSE._serialized_start=4234
+  _EXPIRATIONSRESPONSE._serialized_end=4292
+  _PREDICTTAKEOVERREQUEST._serialized_start=4294
+  _PREDICTTAKEOVERREQUEST._serialized_end=4332
+  _TAKEOVERPREDICTION._serialized_start=4335
+  _TAKEOVERPREDICTION._serialized_end=4527
+  _UPCOMINGTAKEOVERSREQUEST._serialized_start=4529
+  _UPCOMINGTAKEOVERSREQUEST._serialized_end=4571
+  _UPCOMINGTAKEOVERSRESPONSE._serialized_start=4573
+  _UPCOMINGTAKEOVERSRESPONSE._serialized_end=4643
+  _VERIFYCLAIMSIGNATUREREQUEST._serialized_start=4645
+  _VERIFYCLAIMSIGNATUREREQUEST._serialized_end=4692
+  _VERIFYCLAIMSIGNATURERESPONSE._serialized_start=4694
+  _VERIFYCLAIMSIGNATURERESPONSE._serialized_end=4797
+  _HUB._serialized_start=4800
+  _HUB._serialized_end=6134
 # @@protoc_insertion_point(module_scope)
@@ -67,9 +67,69 @@ class HubStub(object):
                 )
         self.Resolve = channel.unary_unary(
                 '/pb.Hub/Resolve',
-                request_serializer=hub__pb2.StringArray.SerializeToString,
+                request_serializer=hub__pb2.ResolveRequest.SerializeToString,
                 response_deserializer=result__pb2.Outputs.FromString,
                 )
+        self.Suggest = channel.unary_unary(
+                '/pb.Hub/Suggest',
+                request_serializer=hub__pb2.SuggestRequest.SerializeToString,
+                response_deserializer=hub__pb2.SuggestResponse.FromString,
+                )
+        self.Related = channel.unary_unary(
+                '/pb.Hub/Related',
+                request_serializer=hub__pb2.RelatedRequest.SerializeToString,
+                response_deserializer=result__pb2.Outputs.FromString,
+                )
+        self.ClaimHistory = channel.unary_unary(
+                '/pb.Hub/ClaimHistory',
+                request_serializer=hub__pb2.ClaimHistoryRequest.SerializeToString,
+                response_deserializer=hub__pb2.ClaimHistoryResponse.FromString,
+                )
+        self.NameBids = channel.unary_unary(
+                '/pb.Hub/NameBids',
+                request_serializer=hub__pb2.NameBidsRequest.SerializeToString,
+                response_deserializer=hub__pb2.NameBidsResponse.FromString,
+                )
+        self.ListSupports = channel.unary_unary(
+                '/pb.Hub/ListSupports',
+                request_serializer=hub__pb2.ListSupportsRequest.SerializeToString,
+                response_deserializer=hub__pb2.ListSupportsResponse.FromString,
+                )
+        self.SupportClaim = channel.unary_unary(
+                '/pb.Hub/SupportClaim',
+                request_serializer=hub__pb2.SupportClaimRequest.SerializeToString,
+                response_deserializer=hub__pb2.SupportClaimResponse.FromString,
+                )
+        self.ChannelClaims = channel.unary_unary(
+                '/pb.Hub/ChannelClaims',
+                request_serializer=hub__pb2.ChannelClaimsRequest.SerializeToString,
+                response_deserializer=result__pb2.Outputs.FromString,
+                )
+        self.ListReposts = channel.unary_unary(
+                '/pb.Hub/ListReposts',
+                request_serializer=hub__pb2.ListRepostsRequest.SerializeToString,
+                response_deserializer=result__pb2.Outputs.FromString,
+                )
+        self.Expirations = channel.unary_unary(
+                '/pb.Hub/Expirations',
+                request_serializer=hub__pb2.ExpirationsRequest.SerializeToString,
+                response_deserializer=hub__pb2.ExpirationsResponse.FromString,
+                )
+        self.PredictTakeover = channel.unary_unary(
+                '/pb.Hub/PredictTakeover',
+                request_serializer=hub__pb2.PredictTakeoverRequest.SerializeToString,
+                response_deserializer=hub__pb2.TakeoverPrediction.FromString,
+                )
+        self.UpcomingTakeovers = channel.unary_unary(
+                '/pb.Hub/UpcomingTakeovers',
+                request_serializer=hub__pb2.UpcomingTakeoversRequest.SerializeToString,
+                response_deserializer=hub__pb2.UpcomingTakeoversResponse.FromString,
+                )
+        self.VerifyClaimSignature = channel.unary_unary(
+                '/pb.Hub/VerifyClaimSignature',
+                request_serializer=hub__pb2.VerifyClaimSignatureRequest.SerializeToString,
+                response_deserializer=hub__pb2.VerifyClaimSignatureResponse.FromString,
+                )
 
 
 class HubServicer(object):
@@ -141,6 +201,78 @@ class HubServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Suggest(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Related(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ClaimHistory(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def NameBids(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListSupports(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SupportClaim(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ChannelClaims(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ListReposts(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Expirations(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def PredictTakeover(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def UpcomingTakeovers(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def VerifyClaimSignature(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_HubServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -196,9 +328,69 @@ def add_HubServicer_to_server(servicer, server):
             ),
             'Resolve': grpc.unary_unary_rpc_method_handler(
                     servicer.Resolve,
-                    request_deserializer=hub__pb2.StringArray.FromString,
+                    request_deserializer=hub__pb2.ResolveRequest.FromString,
                     response_serializer=result__pb2.Outputs.SerializeToString,
             ),
+            'Suggest': grpc.unary_unary_rpc_method_handler(
+                    servicer.Suggest,
+                    request_deserializer=hub__pb2.SuggestRequest.FromString,
+                    response_serializer=hub__pb2.SuggestResponse.SerializeToString,
+            ),
+            'Related': grpc.unary_unary_rpc_method_handler(
+                    servicer.Related,
+                    request_deserializer=hub__pb2.RelatedRequest.FromString,
+                    response_serializer=result__pb2.Outputs.SerializeToString,
+            ),
+            'ClaimHistory': grpc.unary_unary_rpc_method_handler(
+                    servicer.ClaimHistory,
+                    request_deserializer=hub__pb2.ClaimHistoryRequest.FromString,
+                    response_serializer=hub__pb2.ClaimHistoryResponse.SerializeToString,
+            ),
+            'NameBids': grpc.unary_unary_rpc_method_handler(
+                    servicer.NameBids,
+                    request_deserializer=hub__pb2.NameBidsRequest.FromString,
+                    response_serializer=hub__pb2.NameBidsResponse.SerializeToString,
+            ),
+            'ListSupports': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListSupports,
+                    request_deserializer=hub__pb2.ListSupportsRequest.FromString,
+                    response_serializer=hub__pb2.ListSupportsResponse.SerializeToString,
+            ),
+            'SupportClaim': grpc.unary_unary_rpc_method_handler(
+                    servicer.SupportClaim,
+                    request_deserializer=hub__pb2.SupportClaimRequest.FromString,
+                    response_serializer=hub__pb2.SupportClaimResponse.SerializeToString,
+            ),
+            'ChannelClaims': grpc.unary_unary_rpc_method_handler(
+                    servicer.ChannelClaims,
+                    request_deserializer=hub__pb2.ChannelClaimsRequest.FromString,
+                    response_serializer=result__pb2.Outputs.SerializeToString,
+            ),
+            'ListReposts': grpc.unary_unary_rpc_method_handler(
+                    servicer.ListReposts,
+                    request_deserializer=hub__pb2.ListRepostsRequest.FromString,
+                    response_serializer=result__pb2.Outputs.SerializeToString,
+            ),
+            'Expirations': grpc.unary_unary_rpc_method_handler(
+                    servicer.Expirations,
+                    request_deserializer=hub__pb2.ExpirationsRequest.FromString,
+                    response_serializer=hub__pb2.ExpirationsResponse.SerializeToString,
+            ),
+            'PredictTakeover': grpc.unary_unary_rpc_method_handler(
+                    servicer.PredictTakeover,
+                    request_deserializer=hub__pb2.PredictTakeoverRequest.FromString,
+                    response_serializer=hub__pb2.TakeoverPrediction.SerializeToString,
+            ),
+            'UpcomingTakeovers': grpc.unary_unary_rpc_method_handler(
+                    servicer.UpcomingTakeovers,
+                    request_deserializer=hub__pb2.UpcomingTakeoversRequest.FromString,
+                    response_serializer=hub__pb2.UpcomingTakeoversResponse.SerializeToString,
+            ),
+            'VerifyClaimSignature': grpc.unary_unary_rpc_method_handler(
+                    servicer.VerifyClaimSignature,
+                    request_deserializer=hub__pb2.VerifyClaimSignatureRequest.FromString,
+                    response_serializer=hub__pb2.VerifyClaimSignatureResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'pb.Hub', rpc_method_handlers)
@@ -391,7 +583,211 @@ class Hub(object):
             timeout=None,
             metadata=None):
         return grpc.experimental.unary_unary(request, target, '/pb.Hub/Resolve',
-            hub__pb2.StringArray.SerializeToString,
+            hub__pb2.ResolveRequest.SerializeToString,
+            result__pb2.Outputs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Suggest(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/Suggest',
+            hub__pb2.SuggestRequest.SerializeToString,
+            hub__pb2.SuggestResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Related(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/Related',
+            hub__pb2.RelatedRequest.SerializeToString,
             result__pb2.Outputs.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ClaimHistory(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/ClaimHistory',
+            hub__pb2.ClaimHistoryRequest.SerializeToString,
+            hub__pb2.ClaimHistoryResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def NameBids(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/NameBids',
+            hub__pb2.NameBidsRequest.SerializeToString,
+            hub__pb2.NameBidsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListSupports(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/ListSupports',
+            hub__pb2.ListSupportsRequest.SerializeToString,
+            hub__pb2.ListSupportsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def SupportClaim(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/SupportClaim',
+            hub__pb2.SupportClaimRequest.SerializeToString,
+            hub__pb2.SupportClaimResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ChannelClaims(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/ChannelClaims',
+            hub__pb2.ChannelClaimsRequest.SerializeToString,
+            result__pb2.Outputs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def ListReposts(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/ListReposts',
+            hub__pb2.ListRepostsRequest.SerializeToString,
+            result__pb2.Outputs.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Expirations(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/Expirations',
+            hub__pb2.ExpirationsRequest.SerializeToString,
+            hub__pb2.ExpirationsResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def PredictTakeover(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/PredictTakeover',
+            hub__pb2.PredictTakeoverRequest.SerializeToString,
+            hub__pb2.TakeoverPrediction.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def UpcomingTakeovers(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/UpcomingTakeovers',
+            hub__pb2.UpcomingTakeoversRequest.SerializeToString,
+            hub__pb2.UpcomingTakeoversResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def VerifyClaimSignature(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/pb.Hub/VerifyClaimSignature',
+            hub__pb2.VerifyClaimSignatureRequest.SerializeToString,
+            hub__pb2.VerifyClaimSignatureResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
@@ -26,10 +26,25 @@ type Args struct {
 	PrometheusPort              string
 	NotifierPort                string
 	EsIndex                     string
+	EsOverrideIndices           []string
 	RefreshDelta                int
 	CacheTTL                    int
+	StaleCacheTTL               int
+	EsBreakerThreshold          int
+	EsBreakerCooldown           int
 	PeerFile                    string
 	Country                     string
+	RankingProfilesFile         string
+	RateLimit                   float64
+	RateLimitBurst              int
+	RateLimitApiKeys            []string
+	RateLimitApiKeyRate         float64
+	RateLimitApiKeyBurst        int
+	ResolveWorkers              int
+	MaxResolveUrls              int
+	ResolveCacheSize            int
+	ResolveCacheTTL             int
+	AuditSignatures             bool
 	BlockingChannelIds          []string
 	FilteringChannelIds         []string
 	Debug                       bool
@@ -56,8 +71,21 @@ const (
 	DefaultNotifierPort                = "18080"
 	DefaultRefreshDelta                = 5
 	DefaultCacheTTL                    = 5
+	DefaultStaleCacheTTL               = 60
+	DefaultEsBreakerThreshold          = 5
+	DefaultEsBreakerCooldown           = 30
 	DefaultPeerFile                    = "peers.txt"
 	DefaultCountry                     = "US"
+	DefaultRankingProfilesFile         = ""
+	DefaultRateLimit                   = 0
+	DefaultRateLimitBurst              = 100
+	DefaultRateLimitApiKeyRate         = 100
+	DefaultRateLimitApiKeyBurst        = 1000
+	DefaultResolveWorkers              = 8
+	DefaultMaxResolveUrls              = 500
+	DefaultResolveCacheSize            = 100000
+	DefaultResolveCacheTTL             = 30
+	DefaultAuditSignatures             = false
 	DefaultDisableLoadPeers            = false
 	DefaultDisableStartPrometheus      = false
 	DefaultDisableStartUDP             = false
@@ -72,6 +100,8 @@ const (
 var (
 	DefaultBlockingChannelIds  = []string{}
 	DefaultFilteringChannelIds = []string{}
+	DefaultRateLimitApiKeys    = []string{}
+	DefaultEsOverrideIndices   = []string{}
 )
 
 // GetEnvironment takes the environment variables as an array of strings
@@ -112,11 +142,26 @@ func ParseArgs(searchRequest *pb.SearchRequest) *Args {
 	esPort := parser.String("", "esport", &argparse.Options{Required: false, Help: "elasticsearch port", Default: DefaultEsPort})
 	prometheusPort := parser.String("", "prometheus-port", &argparse.Options{Required: false, Help: "prometheus port", Default: DefaultPrometheusPort})
 	notifierPort := parser.String("", "notifier-port", &argparse.Options{Required: false, Help: "notifier port", Default: DefaultNotifierPort})
-	esIndex := parser.String("", "esindex", &argparse.Options{Required: false, Help: "elasticsearch index name", Default: DefaultEsIndex})
+	esIndex := parser.String("", "esindex", &argparse.Options{Required: false, Help: "elasticsearch index name or alias, or a comma separated list of them", Default: DefaultEsIndex})
+	esOverrideIndices := parser.StringList("", "es-override-indices", &argparse.Options{Required: false, Help: "elasticsearch indices a search request is allowed to search instead of the default", Default: DefaultEsOverrideIndices})
 	refreshDelta := parser.Int("", "refresh-delta", &argparse.Options{Required: false, Help: "elasticsearch index refresh delta in seconds", Default: DefaultRefreshDelta})
 	cacheTTL := parser.Int("", "cachettl", &argparse.Options{Required: false, Help: "Cache TTL in minutes", Default: DefaultCacheTTL})
+	staleCacheTTL := parser.Int("", "stale-cache-ttl", &argparse.Options{Required: false, Help: "How long search responses are kept to serve while elasticsearch is down, in minutes", Default: DefaultStaleCacheTTL})
+	esBreakerThreshold := parser.Int("", "es-breaker-threshold", &argparse.Options{Required: false, Help: "Number of consecutive elasticsearch failures before the circuit breaker opens", Default: DefaultEsBreakerThreshold})
+	esBreakerCooldown := parser.Int("", "es-breaker-cooldown", &argparse.Options{Required: false, Help: "Seconds the elasticsearch circuit breaker stays open before retrying", Default: DefaultEsBreakerCooldown})
 	peerFile := parser.String("", "peerfile", &argparse.Options{Required: false, Help: "Initial peer file for federation", Default: DefaultPeerFile})
 	country := parser.String("", "country", &argparse.Options{Required: false, Help: "Country this node is running in. Default US.", Default: DefaultCountry})
+	rankingProfilesFile := parser.String("", "ranking-profiles", &argparse.Options{Required: false, Help: "JSON file with named search ranking profiles", Default: DefaultRankingProfilesFile})
+	rateLimit := parser.Float("", "rate-limit", &argparse.Options{Required: false, Help: "Per client rate limit in request cost per second, 0 to disable", Default: float64(DefaultRateLimit)})
+	rateLimitBurst := parser.Int("", "rate-limit-burst", &argparse.Options{Required: false, Help: "Per client rate limit burst", Default: DefaultRateLimitBurst})
+	rateLimitApiKeys := parser.StringList("", "rate-limit-api-keys", &argparse.Options{Required: false, Help: "Api keys that get the api key rate limit", Default: DefaultRateLimitApiKeys})
+	rateLimitApiKeyRate := parser.Float("", "rate-limit-api-key-rate", &argparse.Options{Required: false, Help: "Rate limit for clients with an api key", Default: float64(DefaultRateLimitApiKeyRate)})
+	rateLimitApiKeyBurst := parser.Int("", "rate-limit-api-key-burst", &argparse.Options{Required: false, Help: "Rate limit burst for clients with an api key", Default: DefaultRateLimitApiKeyBurst})
+	resolveWorkers := parser.Int("", "resolve-workers", &argparse.Options{Required: false, Help: "Number of urls resolved concurrently per resolve request", Default: DefaultResolveWorkers})
+	maxResolveUrls := parser.Int("", "max-resolve-urls", &argparse.Options{Required: false, Help: "Max number of urls in a resolve request", Default: DefaultMaxResolveUrls})
+	resolveCacheSize := parser.Int("", "resolve-cache-size", &argparse.Options{Required: false, Help: "Max number of resolve results to cache, 0 to disable the cache", Default: DefaultResolveCacheSize})
+	resolveCacheTTL := parser.Int("", "resolve-cache-ttl", &argparse.Options{Required: false, Help: "How long resolve results are cached, in minutes", Default: DefaultResolveCacheTTL})
+	auditSignatures := parser.Flag("", "audit-signatures", &argparse.Options{Required: false, Help: "Verify the channel signatures of the claims in each new block and log the ones that don't match the stored flag", Default: DefaultAuditSignatures})
 	blockingChannelIds := parser.StringList("", "blocking-channel-ids", &argparse.Options{Required: false, Help: "Blocking channel ids", Default: DefaultBlockingChannelIds})
 	filteringChannelIds := parser.StringList("", "filtering-channel-ids", &argparse.Options{Required: false, Help: "Filtering channel ids", Default: DefaultFilteringChannelIds})
 
@@ -158,10 +203,25 @@ func ParseArgs(searchRequest *pb.SearchRequest) *Args {
 		PrometheusPort:              *prometheusPort,
 		NotifierPort:                *notifierPort,
 		EsIndex:                     *esIndex,
+		EsOverrideIndices:           *esOverrideIndices,
 		RefreshDelta:                *refreshDelta,
 		CacheTTL:                    *cacheTTL,
+		StaleCacheTTL:               *staleCacheTTL,
+		EsBreakerThreshold:          *esBreakerThreshold,
+		EsBreakerCooldown:           *esBreakerCooldown,
 		PeerFile:                    *peerFile,
 		Country:                     *country,
+		RankingProfilesFile:         *rankingProfilesFile,
+		RateLimit:                   *rateLimit,
+		RateLimitBurst:              *rateLimitBurst,
+		RateLimitApiKeys:            *rateLimitApiKeys,
+		RateLimitApiKeyRate:         *rateLimitApiKeyRate,
+		RateLimitApiKeyBurst:        *rateLimitApiKeyBurst,
+		ResolveWorkers:              *resolveWorkers,
+		MaxResolveUrls:              *maxResolveUrls,
+		ResolveCacheSize:            *resolveCacheSize,
+		ResolveCacheTTL:             *resolveCacheTTL,
+		AuditSignatures:             *auditSignatures,
 		BlockingChannelIds:          *blockingChannelIds,
 		FilteringChannelIds:         *filteringChannelIds,
 		Debug:                       *debug,
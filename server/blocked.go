@@ -0,0 +1,76 @@
+package server
+
+// blocked.go contains the counting of blocked and filtered claims, shared by
+// the endpoints that leave them out of their outputs.
+
+import (
+	"github.com/lbryio/herald/db"
+	pb "github.com/lbryio/herald/protobuf/go"
+)
+
+// blockedClaims counts the claims left out of a listing because they're
+// blocked or filtered, by the channel censoring them.
+type blockedClaims struct {
+	byCensor map[string]*pb.Blocked
+	total    uint32
+}
+
+func newBlockedClaims() *blockedClaims {
+	return &blockedClaims{byCensor: make(map[string]*pb.Blocked)}
+}
+
+// add counts a claim censored by the channel with the given hash, and
+// returns the count for the channel. The caller sets its Channel if it's
+// still nil.
+func (b *blockedClaims) add(censorHash []byte) *pb.Blocked {
+	key := string(censorHash)
+	if b.byCensor[key] == nil {
+		b.byCensor[key] = &pb.Blocked{}
+	}
+	b.byCensor[key].Count += 1
+	b.total += 1
+	return b.byCensor[key]
+}
+
+// list returns the counts for each censoring channel.
+func (b *blockedClaims) list() []*pb.Blocked {
+	res := make([]*pb.Blocked, 0, len(b.byCensor))
+	for _, blocked := range b.byCensor {
+		res = append(res, blocked)
+	}
+	return res
+}
+
+// addIfBlocked counts a claim in blocked and returns true if it's blocked or
+// filtered, by itself, its channel or the claim it reposts.
+func (s *Server) addIfBlocked(blocked *blockedClaims, claimHash []byte) (bool, error) {
+	blockerHash, filterHash, err := s.DB.GetClaimBlockerHash(claimHash)
+	if err != nil {
+		return false, err
+	}
+	censorHash := blockerHash
+	if censorHash == nil {
+		censorHash = filterHash
+	}
+	if censorHash == nil {
+		return false, nil
+	}
+	if b := blocked.add(censorHash); b.Channel == nil {
+		if censor, err := s.DB.FsGetClaimByHash(censorHash); err == nil {
+			b.Channel = censor.ToOutput()
+		}
+	}
+	return true, nil
+}
+
+// addIfResolveBlocked counts a resolve result in blocked if it was blocked
+// or filtered.
+func addIfResolveBlocked(blocked *blockedClaims, res *db.ExpandedResolveResult) {
+	x := res.Channel.GetError()
+	if x == nil || x.CensorHash == nil {
+		return
+	}
+	if b := blocked.add(x.CensorHash); b.Channel == nil && x.Censor != nil {
+		b.Channel = x.Censor.ToOutput()
+	}
+}
@@ -0,0 +1,44 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+)
+
+// TestBlockedCounts tests that listing a channel's claims and a search for
+// the channel answered from the db count its blocked claims the same way,
+// with the censoring channel.
+func TestBlockedCounts(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.Args.DisableEs = false
+	hubServer.DB = openTestDB(t, "../testdata/J_channel_claims.csv")
+	hubServer.EsBreaker = server.NewCircuitBreaker(1, time.Minute)
+	hubServer.EsBreaker.Failure(time.Now())
+	// The channel censors its own claim d-blocked.
+	channelHash := bytes.Repeat([]byte{0xcc}, 20)
+	hubServer.DB.BlockedStreams[string(bytes.Repeat([]byte{5}, 20))] = channelHash
+	channelId := "cccccccccccccccccccccccccccccccccccccccc"
+
+	listed, err := hubServer.ChannelClaims(ctx, &pb.ChannelClaimsRequest{ChannelId: channelId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	searched, err := hubServer.Search(ctx, &pb.SearchRequest{ChannelId: &pb.InvertibleField{Value: []string{channelId}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, out := range []*pb.Outputs{listed, searched} {
+		if out.BlockedTotal != 1 || len(out.Blocked) != 1 || out.Blocked[0].Count != 1 {
+			t.Fatalf("expected 1 blocked claim, got %v", out.Blocked)
+		}
+		if channel := out.Blocked[0].Channel; channel == nil || channel.GetClaim() == nil {
+			t.Errorf("expected the censoring channel, got %v", channel)
+		}
+	}
+}
@@ -0,0 +1,345 @@
+package server
+
+// breaker.go contains the circuit breaker around elasticsearch, and the
+// degraded search that's used while it's open.
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lbryio/herald/db"
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/olivere/elastic/v7"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Circuit breaker states.
+const (
+	BreakerClosed   = 0
+	BreakerHalfOpen = 1
+	BreakerOpen     = 2
+)
+
+// StaleCacheSize is the max number of search responses kept to serve while
+// es is down.
+const StaleCacheSize = 10000
+
+// breakerStateNames are the names of the breaker states, for the health
+// endpoint.
+var breakerStateNames = map[int]string{
+	BreakerClosed:   "closed",
+	BreakerHalfOpen: "half-open",
+	BreakerOpen:     "open",
+}
+
+// CircuitBreaker stops calls to a service after Threshold consecutive
+// failures. After Cooldown it lets a single probe call through, and closes
+// if it succeeds or opens again if it fails. A probe that doesn't report
+// back within Cooldown is given up on and another one is let through.
+type CircuitBreaker struct {
+	Threshold int
+	Cooldown  time.Duration
+
+	state    int
+	failures int
+	openedAt time.Time
+	probeAt  time.Time
+	mut      sync.Mutex
+}
+
+// NewCircuitBreaker creates a closed circuit breaker.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	metrics.EsBreakerState.Set(BreakerClosed)
+	return &CircuitBreaker{
+		Threshold: threshold,
+		Cooldown:  cooldown,
+		state:     BreakerClosed,
+	}
+}
+
+// setState sets the state and updates the metric, must hold the lock.
+func (b *CircuitBreaker) setState(state int) {
+	b.state = state
+	metrics.EsBreakerState.Set(float64(state))
+}
+
+// Allow returns true if a call should be attempted at time now.
+func (b *CircuitBreaker) Allow(now time.Time) bool {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		if now.Sub(b.openedAt) < b.Cooldown {
+			return false
+		}
+		b.setState(BreakerHalfOpen)
+	case BreakerHalfOpen:
+		if now.Sub(b.probeAt) < b.Cooldown {
+			return false
+		}
+	default:
+		return true
+	}
+	b.probeAt = now
+	return true
+}
+
+// Success records a successful call.
+func (b *CircuitBreaker) Success() {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	b.failures = 0
+	if b.state != BreakerClosed {
+		log.Println("ElasticSearch is back, closing circuit breaker")
+		b.setState(BreakerClosed)
+	}
+}
+
+// Failure records a failed call at time now.
+func (b *CircuitBreaker) Failure(now time.Time) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	b.failures++
+	if b.state == BreakerHalfOpen || (b.state == BreakerClosed && b.failures >= b.Threshold) {
+		log.Println("ElasticSearch is failing, opening circuit breaker")
+		metrics.EsBreakerTrips.Inc()
+		b.openedAt = now
+		b.setState(BreakerOpen)
+	}
+}
+
+// Release gives up on a half-open probe without a result, so the next call
+// is let through as a new probe.
+func (b *CircuitBreaker) Release() {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	if b.state == BreakerHalfOpen {
+		b.probeAt = time.Time{}
+	}
+}
+
+// State returns the current state of the breaker.
+func (b *CircuitBreaker) State() int {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	return b.state
+}
+
+// IsIdOnlyQuery returns true if a search request only asks for claims by full
+// claim id and / or channel id, so it can be answered from the db.
+func IsIdOnlyQuery(in *pb.SearchRequest) bool {
+	if in.ClaimId == nil && in.ChannelId == nil {
+		return false
+	}
+	for _, field := range []*pb.InvertibleField{in.ClaimId, in.ChannelId} {
+		if field == nil {
+			continue
+		}
+		if field.Invert || len(field.Value) == 0 {
+			return false
+		}
+		for _, id := range field.Value {
+			if len(id) != 40 {
+				return false
+			}
+		}
+	}
+	rest := proto.Clone(in).(*pb.SearchRequest)
+	rest.ClaimId = nil
+	rest.ChannelId = nil
+	rest.Limit = 0
+	rest.Offset = 0
+	rest.NoTotals = false
+	rest.IncludeClaimValues = false
+	return proto.Equal(rest, &pb.SearchRequest{})
+}
+
+// degradedSearch answers a search while es is down, with a stale cached
+// response if there is one, or from the db for claim id / channel id only
+// queries. Otherwise it returns Unavailable.
+func (s *Server) degradedSearch(in *pb.SearchRequest, cacheKey string) (*pb.Outputs, error) {
+	if val, err := s.StaleCache.Get(cacheKey); err == nil {
+		metrics.DegradedSearches.With(prometheus.Labels{"source": "stale_cache"}).Inc()
+		return val.(*pb.Outputs), nil
+	}
+	if s.DB != nil && IsIdOnlyQuery(in) {
+		metrics.DegradedSearches.With(prometheus.Labels{"source": "db"}).Inc()
+		return s.searchFromDB(in)
+	}
+	metrics.DegradedSearches.With(prometheus.Labels{"source": "unavailable"}).Inc()
+	return nil, status.Error(codes.Unavailable, "elasticsearch is unavailable")
+}
+
+// searchFromDB looks up the claims in a claim id / channel id only search
+// request in the db.
+func (s *Server) searchFromDB(in *pb.SearchRequest) (*pb.Outputs, error) {
+	var from = 0
+	var pageSize = 10
+	setPageVars(in, &pageSize, &from)
+
+	channelIds := make(map[string]bool)
+	if in.ChannelId != nil {
+		for _, id := range in.ChannelId.Value {
+			channelIds[id] = true
+		}
+	}
+
+	var claimHashes [][]byte
+	if in.ClaimId != nil {
+		for _, id := range in.ClaimId.Value {
+			claimHash, err := hex.DecodeString(id)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid claim id %s", id)
+			}
+			claimHashes = append(claimHashes, claimHash)
+		}
+	} else {
+		for _, id := range in.ChannelId.Value {
+			channelHash, err := hex.DecodeString(id)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid channel id %s", id)
+			}
+			it, err := s.DB.ChannelClaimsIter(context.Background(), channelHash)
+			if err != nil {
+				return nil, status.Error(codes.Unavailable, "channel claims are unavailable")
+			}
+			for it.Next() {
+				claimHashes = append(claimHashes, it.Value().ClaimHash)
+			}
+			err = it.Err()
+			it.Close()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	txos := make([]*pb.Output, 0, pageSize)
+	blocked := newBlockedClaims()
+	var total = 0
+	for _, claimHash := range claimHashes {
+		isBlocked, err := s.addIfBlocked(blocked, claimHash)
+		if err != nil {
+			return nil, err
+		} else if isBlocked {
+			continue
+		}
+
+		// Only claims on this page are resolved, unless we need the channel to
+		// filter by it.
+		var res *db.ResolveResult = nil
+		if in.ClaimId != nil && len(channelIds) > 0 {
+			res, err = s.DB.FsGetClaimByHash(claimHash)
+			if err != nil {
+				log.Println("Error getting claim from db: ", err)
+				continue
+			}
+			if !channelIds[hex.EncodeToString(res.ChannelHash)] {
+				continue
+			}
+		}
+		if total >= from && len(txos) < pageSize {
+			if res == nil {
+				res, err = s.DB.FsGetClaimByHash(claimHash)
+				if err != nil {
+					log.Println("Error getting claim from db: ", err)
+					continue
+				}
+			}
+			txos = append(txos, res.ToOutput())
+		}
+		total += 1
+	}
+
+	out := &pb.Outputs{
+		Txos:         txos,
+		Total:        uint32(total),
+		Offset:       uint32(from + len(txos)),
+		Blocked:      blocked.list(),
+		BlockedTotal: blocked.total,
+	}
+	if in.NoTotals {
+		out.Total, out.BlockedTotal = 0, 0
+	}
+	if in.IncludeClaimValues {
+		s.addClaimValues(out)
+	}
+	return out, nil
+}
+
+// healthHandler is an http endpoint that reports the state of the es
+// circuit breaker and the db. It returns 503 while the breaker is open.
+func (s *Server) healthHandler(w http.ResponseWriter, r *http.Request) {
+	health := map[string]interface{}{
+		"es": "disabled",
+	}
+	code := http.StatusOK
+	if s.EsBreaker != nil && !s.Args.DisableEs {
+		state := s.EsBreaker.State()
+		health["es"] = breakerStateNames[state]
+		if state == BreakerOpen {
+			code = http.StatusServiceUnavailable
+		}
+	}
+	if s.DB != nil && s.DB.LastState != nil {
+		health["db_height"] = s.DB.LastState.Height
+	}
+	data, err := json.Marshal(health)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(data)
+}
+
+// esDone records the result of es calls the breaker let through, and is
+// deferred so a half-open probe is resolved whichever way the request ends.
+// Only outages are failures, an error like a bad query still shows es is up.
+// If the caller gave up there's no result and the probe is released.
+func (s *Server) esDone(ctx context.Context, err error) {
+	if isEsOutage(ctx, err) {
+		s.EsBreaker.Failure(time.Now())
+	} else if err != nil && ctx.Err() != nil {
+		s.EsBreaker.Release()
+	} else {
+		s.EsBreaker.Success()
+	}
+}
+
+// isEsOutage returns true if an es error means es is down or overloaded,
+// rather than something wrong with the request. Errors after ctx is done
+// are the caller giving up, e.g. a client's own short deadline, so they
+// don't count.
+func isEsOutage(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	if elastic.IsConnErr(err) || elastic.IsTimeout(err) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var esErr *elastic.Error
+	if errors.As(err, &esErr) {
+		return esErr.Status >= 500
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"github.com/olivere/elastic/v7"
+)
+
+// TestCircuitBreaker tests the circuit breaker opening after enough failures
+// and closing again after the cooldown.
+func TestCircuitBreaker(t *testing.T) {
+	b := server.NewCircuitBreaker(2, time.Second*30)
+	now := time.Unix(1650000000, 0)
+
+	b.Failure(now)
+	if !b.Allow(now) || b.State() != server.BreakerClosed {
+		t.Error("Expected breaker to stay closed after one failure")
+	}
+	b.Failure(now)
+	if b.Allow(now) || b.State() != server.BreakerOpen {
+		t.Error("Expected breaker to open after two failures")
+	}
+	if b.Allow(now.Add(time.Second * 29)) {
+		t.Error("Expected breaker to stay open during the cooldown")
+	}
+	if !b.Allow(now.Add(time.Second*30)) || b.State() != server.BreakerHalfOpen {
+		t.Error("Expected breaker to be half-open after the cooldown")
+	}
+	if b.Allow(now.Add(time.Second * 31)) {
+		t.Error("Expected breaker to let a single probe through while half-open")
+	}
+	if !b.Allow(now.Add(time.Second * 60)) {
+		t.Error("Expected breaker to let another probe through when one didn't report back")
+	}
+	b.Failure(now.Add(time.Second * 60))
+	if b.State() != server.BreakerOpen {
+		t.Error("Expected a failure while half-open to open the breaker")
+	}
+	b.Allow(now.Add(time.Second * 90))
+	b.Release()
+	if !b.Allow(now.Add(time.Second*91)) || b.State() != server.BreakerHalfOpen {
+		t.Error("Expected breaker to let another probe through when one was released")
+	}
+	b.Success()
+	if b.State() != server.BreakerClosed {
+		t.Error("Expected a success while half-open to close the breaker")
+	}
+}
+
+// TestSearchResolvesProbe tests that a search let through as the half-open
+// probe resolves it even when es rejects the query, since es answering shows
+// it's up.
+func TestSearchResolvesProbe(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"type":"parsing_exception","reason":"bad query"},"status":400}`))
+	}))
+	defer ts.Close()
+	client, err := elastic.NewClient(elastic.SetURL(ts.URL), elastic.SetSniff(false), elastic.SetHealthcheck(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.Args.DisableEs = false
+	hubServer.EsClient = client
+	hubServer.EsBreaker = server.NewCircuitBreaker(1, time.Millisecond)
+	hubServer.EsBreaker.Failure(time.Now())
+	time.Sleep(time.Millisecond * 2)
+
+	if _, err := hubServer.Search(ctx, &pb.SearchRequest{Text: "cats"}); err == nil {
+		t.Fatal("Expected the bad request error")
+	}
+	if state := hubServer.EsBreaker.State(); state != server.BreakerClosed {
+		t.Errorf("Expected the probe to close the breaker, got %d", state)
+	}
+}
+
+// TestIsEsOutage tests which es errors count towards opening the breaker.
+func TestIsEsOutage(t *testing.T) {
+	isEsOutage := server.IsEsOutageExported()
+	ctx := context.Background()
+	done, cancel := context.WithTimeout(ctx, 0)
+	defer cancel()
+	connErr := &url.Error{Op: "Post", URL: "http://localhost:9200", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{"no error", ctx, nil, false},
+		{"no client", ctx, elastic.ErrNoClient, true},
+		{"connection refused", ctx, connErr, true},
+		{"es timeout", ctx, &elastic.Error{Status: http.StatusRequestTimeout}, true},
+		{"es overloaded", ctx, &elastic.Error{Status: http.StatusServiceUnavailable}, true},
+		{"bad request", ctx, &elastic.Error{Status: http.StatusBadRequest}, false},
+		{"client deadline", done, &url.Error{Op: "Post", URL: "http://localhost:9200", Err: context.DeadlineExceeded}, false},
+		{"client deadline bare", done, context.DeadlineExceeded, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isEsOutage(tt.ctx, tt.err); got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestIsIdOnlyQuery tests which search requests can be answered from the db.
+func TestIsIdOnlyQuery(t *testing.T) {
+	id := "2556ed1cab9d17f2a9392030a9ad7f5d138f11bd"
+	tests := []struct {
+		name string
+		in   *pb.SearchRequest
+		want bool
+	}{
+		{"empty", &pb.SearchRequest{}, false},
+		{"claim ids", &pb.SearchRequest{ClaimId: &pb.InvertibleField{Value: []string{id}}, Limit: 5}, true},
+		{"channel ids", &pb.SearchRequest{ChannelId: &pb.InvertibleField{Value: []string{id}}, Offset: 10}, true},
+		{"inverted", &pb.SearchRequest{ClaimId: &pb.InvertibleField{Invert: true, Value: []string{id}}}, false},
+		{"short id", &pb.SearchRequest{ClaimId: &pb.InvertibleField{Value: []string{"2556"}}}, false},
+		{"text", &pb.SearchRequest{ClaimId: &pb.InvertibleField{Value: []string{id}}, Text: "cats"}, false},
+		{"claim values", &pb.SearchRequest{ClaimId: &pb.InvertibleField{Value: []string{id}}, IncludeClaimValues: true}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := server.IsIdOnlyQuery(tt.in); got != tt.want {
+				t.Errorf("got: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package server
+
+// channel_claims.go contains the endpoint listing the claims in a channel
+// from the db.
+
+import (
+	"context"
+	"time"
+
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultChannelClaimsLimit is the number of claims returned if the
+	// request doesn't set a limit, and MaxChannelClaimsLimit the most.
+	DefaultChannelClaimsLimit = 50
+	MaxChannelClaimsLimit     = 200
+	// MaxChannelClaimsTotal is the most claims counted in the total, the
+	// rest of a bigger channel isn't walked once the page is full.
+	MaxChannelClaimsTotal = 1000
+)
+
+// channelClaimTypes are the claim types a ChannelClaims request can filter
+// by.
+var channelClaimTypes = map[string]bool{
+	"stream":     true,
+	"channel":    true,
+	"repost":     true,
+	"collection": true,
+}
+
+// ChannelClaims is a grpc endpoint that lists a page of the claims in a
+// channel, by name, straight from the db so it doesn't depend on es.
+// Blocked and filtered claims are counted in blocked instead. The total
+// stops at MaxChannelClaimsTotal, or the end of the page if it's further.
+func (s *Server) ChannelClaims(ctx context.Context, in *pb.ChannelClaimsRequest) (*pb.Outputs, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "channel_claims"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "channel_claims"}).
+			Observe(delta)
+	}(time.Now())
+
+	return s.channelClaims(ctx, in, MaxChannelClaimsTotal)
+}
+
+// channelClaims lists a page of the claims in a channel, counting at most
+// maxTotal claims once the page is full.
+func (s *Server) channelClaims(ctx context.Context, in *pb.ChannelClaimsRequest, maxTotal int) (*pb.Outputs, error) {
+	channelHash, err := parseClaimId(in.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	claimTypes := make(map[string]bool)
+	for _, claimType := range in.ClaimType {
+		if !channelClaimTypes[claimType] {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid claim type %q", claimType)
+		}
+		claimTypes[claimType] = true
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "channel claims are unavailable")
+	}
+	channelTxo, err := s.DB.GetCachedClaimTxo(channelHash, true)
+	if err != nil {
+		return nil, err
+	} else if channelTxo == nil {
+		return nil, status.Errorf(codes.NotFound, "channel %s not found", in.ChannelId)
+	}
+
+	limit := int(in.Limit)
+	if limit == 0 {
+		limit = DefaultChannelClaimsLimit
+	} else if limit > MaxChannelClaimsLimit {
+		limit = MaxChannelClaimsLimit
+	}
+	from := int(in.Offset)
+
+	it, err := s.DB.ChannelClaimsIter(ctx, channelHash)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "channel claims are unavailable")
+	}
+	defer it.Close()
+	txos := make([]*pb.Output, 0, limit)
+	blocked := newBlockedClaims()
+	var total = 0
+	for it.Next() {
+		if len(txos) == limit && total >= maxTotal {
+			break
+		}
+		key := it.Key()
+		claimHash := it.Value().ClaimHash
+
+		if len(claimTypes) > 0 {
+			txHash, err := s.DB.GetTxHash(key.TxNum)
+			if err != nil {
+				return nil, err
+			}
+			claimType, err := s.DB.GetClaimType(txHash, uint32(key.Position))
+			if err != nil {
+				return nil, err
+			}
+			if !claimTypes[claimType] {
+				continue
+			}
+		}
+
+		isBlocked, err := s.addIfBlocked(blocked, claimHash)
+		if err != nil {
+			return nil, err
+		} else if isBlocked {
+			continue
+		}
+
+		// Only claims on this page are resolved.
+		if total >= from && len(txos) < limit {
+			res, err := s.DB.FsGetClaimByHash(claimHash)
+			if err != nil {
+				return nil, err
+			}
+			txos = append(txos, res.ToOutput())
+		}
+		total += 1
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return &pb.Outputs{
+		Txos:         txos,
+		Total:        uint32(total),
+		Offset:       uint32(from + len(txos)),
+		Blocked:      blocked.list(),
+		BlockedTotal: blocked.total,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestChannelClaims tests paging through the claims in a channel, filtering
+// them by type, and leaving out blocked claims. The test data has claims
+// a-stream, b-repost, c-legacy, d-blocked and e-collection, in txs 2 to 6,
+// whose claim hashes are their tx num repeated.
+func TestChannelClaims(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/J_channel_claims.csv")
+	censorHash := bytes.Repeat([]byte{0xdd}, 20)
+	hubServer.DB.BlockedStreams[string(bytes.Repeat([]byte{5}, 20))] = censorHash
+	channelId := "cccccccccccccccccccccccccccccccccccccccc"
+
+	tests := []struct {
+		name   string
+		req    *pb.ChannelClaimsRequest
+		txNums []uint32
+		total  uint32
+	}{
+		{"all", &pb.ChannelClaimsRequest{ChannelId: channelId}, []uint32{2, 3, 4, 6}, 4},
+		{"page", &pb.ChannelClaimsRequest{ChannelId: channelId, Offset: 1, Limit: 2}, []uint32{3, 4}, 4},
+		{"streams", &pb.ChannelClaimsRequest{ChannelId: channelId, ClaimType: []string{"stream"}}, []uint32{2, 4}, 2},
+		{"reposts and collections", &pb.ChannelClaimsRequest{ChannelId: channelId, ClaimType: []string{"repost", "collection"}}, []uint32{3, 6}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := hubServer.ChannelClaims(ctx, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out.Total != tt.total {
+				t.Errorf("expected a total of %d, got %d", tt.total, out.Total)
+			}
+			if len(out.Txos) != len(tt.txNums) {
+				t.Fatalf("expected %d claims, got %v", len(tt.txNums), out.Txos)
+			}
+			for i, txNum := range tt.txNums {
+				txHash, err := hubServer.DB.GetTxHash(txNum)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(out.Txos[i].TxHash, txHash) {
+					t.Errorf("expected claim %d to be in tx %d, got %x", i, txNum, out.Txos[i].TxHash)
+				}
+			}
+		})
+	}
+
+	// Once the page is full, claims are only counted up to the max total.
+	channelClaims := hubServer.ChannelClaimsExported()
+	out, err := channelClaims(ctx, &pb.ChannelClaimsRequest{ChannelId: channelId, Limit: 1}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Txos) != 1 || out.Total != 2 {
+		t.Errorf("expected 1 claim of a total of 2, got %d of %d", len(out.Txos), out.Total)
+	}
+	out, err = channelClaims(ctx, &pb.ChannelClaimsRequest{ChannelId: channelId, Offset: 2, Limit: 1}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Txos) != 1 || out.Total != 3 {
+		t.Errorf("expected 1 claim of a total of 3, got %d of %d", len(out.Txos), out.Total)
+	}
+
+	out, err = hubServer.ChannelClaims(ctx, &pb.ChannelClaimsRequest{ChannelId: channelId, ClaimType: []string{"stream"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.BlockedTotal != 1 || len(out.Blocked) != 1 || out.Blocked[0].Count != 1 {
+		t.Errorf("expected 1 blocked claim, got %v", out.Blocked)
+	}
+
+	errTests := []struct {
+		name string
+		req  *pb.ChannelClaimsRequest
+		code codes.Code
+	}{
+		{"bad channel id", &pb.ChannelClaimsRequest{ChannelId: "cc"}, codes.InvalidArgument},
+		{"bad claim type", &pb.ChannelClaimsRequest{ChannelId: channelId, ClaimType: []string{"video"}}, codes.InvalidArgument},
+		{"missing channel", &pb.ChannelClaimsRequest{ChannelId: "ffffffffffffffffffffffffffffffffffffffff"}, codes.NotFound},
+	}
+	for _, tt := range errTests {
+		_, err := hubServer.ChannelClaims(ctx, tt.req)
+		if status.Code(err) != tt.code {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.code, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package server
+
+// claim_history.go contains the endpoint listing the txs that created,
+// updated and abandoned a claim.
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/lbryio/herald/db"
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClaimHistory is a grpc endpoint that lists the txs that created, updated
+// and abandoned a claim, oldest first, with the amount and channel signature
+// of the claim in each. Claims abandoned before the blocks kept for reorgs
+// aren't in the db anymore, so they're NotFound.
+func (s *Server) ClaimHistory(ctx context.Context, in *pb.ClaimHistoryRequest) (*pb.ClaimHistoryResponse, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "claim_history"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "claim_history"}).
+			Observe(delta)
+	}(time.Now())
+
+	claimHash, err := parseClaimId(in.ClaimId)
+	if err != nil {
+		return nil, err
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "claim history is unavailable")
+	}
+
+	entries, err := s.DB.GetClaimHistory(claimHash)
+	if err != nil {
+		return nil, err
+	} else if entries == nil {
+		return nil, status.Errorf(codes.NotFound, "claim %s not found", in.ClaimId)
+	}
+
+	res := &pb.ClaimHistoryResponse{
+		Entries: make([]*pb.ClaimHistoryEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		typ := pb.ClaimHistoryEntry_CREATE
+		switch entry.Type {
+		case db.ClaimUpdated:
+			typ = pb.ClaimHistoryEntry_UPDATE
+		case db.ClaimAbandoned:
+			typ = pb.ClaimHistoryEntry_ABANDON
+		}
+		var channelId string
+		if entry.ChannelHash != nil {
+			channelId = hex.EncodeToString(entry.ChannelHash)
+		}
+		res.Entries = append(res.Entries, &pb.ClaimHistoryEntry{
+			Type:      typ,
+			TxHash:    entry.TxHash,
+			Nout:      entry.Nout,
+			Height:    entry.Height,
+			Amount:    entry.Amount,
+			ChannelId: channelId,
+			Signature: entry.Signature,
+		})
+	}
+	return res, nil
+}
+
+// parseClaimId decodes a hex claim id into a claim hash.
+func parseClaimId(claimId string) ([]byte, error) {
+	claimHash, err := hex.DecodeString(claimId)
+	if err != nil || len(claimHash) != 20 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid claim id %q", claimId)
+	}
+	return claimHash, nil
+}
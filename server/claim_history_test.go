@@ -0,0 +1,63 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestClaimHistory tests listing the txs of an updated claim and of an
+// abandoned one, and the errors for bad and missing claim ids.
+func TestClaimHistory(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/B_claim_history.csv")
+	if err := hubServer.DB.InitTxCounts(); err != nil {
+		t.Fatal(err)
+	}
+	hubServer.DB.Height = 6
+
+	historyTests := []struct {
+		claimId   string
+		wantTypes []pb.ClaimHistoryEntry_Type
+	}{
+		{"df01d1f2e91cc18f43916b7e9097c2baa4e69ddb", []pb.ClaimHistoryEntry_Type{pb.ClaimHistoryEntry_CREATE, pb.ClaimHistoryEntry_UPDATE, pb.ClaimHistoryEntry_UPDATE}},
+		{"9dd0150f15687912b0c64702bb750ac94ad50f1e", []pb.ClaimHistoryEntry_Type{pb.ClaimHistoryEntry_CREATE, pb.ClaimHistoryEntry_ABANDON}},
+	}
+	for _, tt := range historyTests {
+		res, err := hubServer.ClaimHistory(ctx, &pb.ClaimHistoryRequest{ClaimId: tt.claimId})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Entries) != len(tt.wantTypes) {
+			t.Fatalf("expected %d entries for %s, got %v", len(tt.wantTypes), tt.claimId, res.Entries)
+		}
+		for i, typ := range tt.wantTypes {
+			if res.Entries[i].Type != typ {
+				t.Errorf("expected entry %d of %s to be %v, got %v", i, tt.claimId, typ, res.Entries[i].Type)
+			}
+		}
+		if tt.wantTypes[1] == pb.ClaimHistoryEntry_UPDATE && (res.Entries[1].ChannelId != "cccccccccccccccccccccccccccccccccccccccc" || len(res.Entries[1].Signature) != 64) {
+			t.Errorf("expected the second entry to be signed, got %v", res.Entries[1])
+		}
+	}
+
+	tests := []struct {
+		claimId string
+		code    codes.Code
+	}{
+		{"not hex", codes.InvalidArgument},
+		{"e7fb", codes.InvalidArgument},
+		{"ffffffffffffffffffffffffffffffffffffffff", codes.NotFound},
+	}
+	for _, tt := range tests {
+		_, err := hubServer.ClaimHistory(ctx, &pb.ClaimHistoryRequest{ClaimId: tt.claimId})
+		if status.Code(err) != tt.code {
+			t.Errorf("expected %v for %q, got %v", tt.code, tt.claimId, err)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+// claim_values.go contains the functions that add the decoded claims to
+// the outputs of requests with include_claim_values set.
+
+import (
+	"log"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+)
+
+// addClaimValues adds the decoded claim, signature and signing channel public
+// key to the claim outputs in out. Claims that can't be decoded, like legacy
+// claims, are left as they are.
+func (s *Server) addClaimValues(out *pb.Outputs) {
+	if s.DB == nil || out == nil {
+		return
+	}
+	publicKeys := make(map[string][]byte)
+	for _, txos := range [][]*pb.Output{out.Txos, out.ExtraTxos} {
+		for _, txo := range txos {
+			if txo.GetClaim() == nil || txo.Value != nil {
+				continue
+			}
+			value, err := s.DB.GetClaimValue(txo.TxHash, txo.Nout)
+			if err != nil {
+				log.Printf("Error decoding claim %x:%d: %v\n", txo.TxHash, txo.Nout, err)
+				continue
+			}
+			txo.Value = value.Claim
+			txo.Signature = value.Signature
+			if value.ChannelHash == nil {
+				continue
+			}
+			key := string(value.ChannelHash)
+			publicKey, ok := publicKeys[key]
+			if !ok {
+				publicKey, err = s.DB.GetChannelPublicKey(value.ChannelHash)
+				if err != nil {
+					log.Printf("Error getting public key of channel %x: %v\n", value.ChannelHash, err)
+				}
+				publicKeys[key] = publicKey
+			}
+			txo.ChannelPublicKey = publicKey
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+)
+
+// TestSearchClaimValues tests that a search only adds the decoded claims to
+// its outputs when the request asks for them. The breaker is open so the
+// search is answered from the db.
+func TestSearchClaimValues(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.Args.DisableEs = false
+	hubServer.DB = openTestDB(t, "../testdata/J_channel_claims.csv")
+	hubServer.EsBreaker = server.NewCircuitBreaker(1, time.Minute)
+	hubServer.EsBreaker.Failure(time.Now())
+	claimId := "0202020202020202020202020202020202020202"
+
+	out, err := hubServer.Search(ctx, &pb.SearchRequest{ClaimId: &pb.InvertibleField{Value: []string{claimId}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Txos) != 1 || out.Txos[0].GetClaim() == nil {
+		t.Fatalf("expected 1 claim, got %v", out.Txos)
+	}
+	if out.Txos[0].Value != nil {
+		t.Errorf("expected no claim value, got %v", out.Txos[0].Value)
+	}
+
+	out, err = hubServer.Search(ctx, &pb.SearchRequest{ClaimId: &pb.InvertibleField{Value: []string{claimId}}, IncludeClaimValues: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Txos) != 1 || out.Txos[0].Value.GetStream() == nil {
+		t.Errorf("expected the decoded stream, got %v", out.Txos)
+	}
+}
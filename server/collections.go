@@ -0,0 +1,75 @@
+package server
+
+// collections.go contains the expansion of collection claims in resolve
+// outputs.
+
+import (
+	"errors"
+
+	"github.com/lbryio/herald/db"
+	pb "github.com/lbryio/herald/protobuf/go"
+)
+
+const (
+	// DefaultCollectionLimit is the number of claims returned per collection
+	// if the request doesn't set a limit, and MaxCollectionLimit the most.
+	DefaultCollectionLimit = 50
+	MaxCollectionLimit     = 200
+)
+
+// expandCollection resolves a page of the claims in txo if it's a
+// collection. The number of claims and a reference to each claim in the
+// page, or an error if it's missing or blocked, are set in the claim meta of
+// txo. It returns the results of the claims in the page, and their outputs
+// along with their channels, reposts and censoring channels.
+func (s *Server) expandCollection(txo *pb.Output, offset, limit uint32) ([]*db.ExpandedResolveResult, []*pb.Output, error) {
+	meta := txo.GetClaim()
+	if meta == nil {
+		return nil, nil, nil
+	}
+	// Legacy claims can't be decoded, but they can't be collections either.
+	value, err := s.DB.GetClaimValue(txo.TxHash, txo.Nout)
+	if errors.Is(err, db.ErrUndecodableClaim) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+	if value.Claim.GetCollection() == nil {
+		return nil, nil, nil
+	}
+
+	claimHashes := db.CollectionClaimHashes(value.Claim)
+	meta.CollectionCount = uint32(len(claimHashes))
+	if limit == 0 {
+		limit = DefaultCollectionLimit
+	} else if limit > MaxCollectionLimit {
+		limit = MaxCollectionLimit
+	}
+	if offset >= uint32(len(claimHashes)) {
+		return nil, nil, nil
+	}
+	end := offset + limit
+	if end > uint32(len(claimHashes)) {
+		end = uint32(len(claimHashes))
+	}
+
+	results := make([]*db.ExpandedResolveResult, 0, end-offset)
+	extraTxos := make([]*pb.Output, 0, end-offset)
+	for _, claimHash := range claimHashes[offset:end] {
+		res := s.DB.ResolveClaimHash(claimHash)
+		txos, resExtraTxos, err := res.ToOutputs()
+		if err != nil {
+			return nil, nil, err
+		}
+		// Claims resolved by hash always have a stream or an error output.
+		item := txos[0]
+		if item.GetClaim() != nil {
+			extraTxos = append(extraTxos, item)
+			item = &pb.Output{TxHash: item.TxHash, Nout: item.Nout, Height: item.Height}
+		}
+		meta.CollectionItems = append(meta.CollectionItems, item)
+		extraTxos = append(extraTxos, resExtraTxos...)
+		results = append(results, res)
+	}
+	return results, extraTxos, nil
+}
@@ -0,0 +1,83 @@
+package server_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+)
+
+// TestExpandCollection tests that a collection is expanded into a reference
+// or an error per claim, with paging.
+func TestExpandCollection(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/FULL_collection.csv")
+	expandCollection := hubServer.ExpandCollectionExported()
+
+	// The collection has the channel below and a claim that doesn't exist.
+	collectionTxHash, _ := hex.DecodeString("83d3932f1959bf639a3ad1b7519dc53dd7c5207812e307fa3dfad39176c2cd75")
+	channelHash, _ := hex.DecodeString("2556ed1cab9d17f2a9392030a9ad7f5d138f11bd")
+	channelTxHash, _ := hex.DecodeString("54e14ff0c404c29b3d39ae4d249435f167d5cd4ce5a428ecb745b3df1c8e3dde")
+	newCollection := func() *pb.Output {
+		return &pb.Output{TxHash: collectionTxHash, Meta: &pb.Output_Claim{Claim: &pb.ClaimMeta{}}}
+	}
+
+	txo := newCollection()
+	results, extraTxos, err := expandCollection(txo, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := txo.GetClaim()
+	if meta.CollectionCount != 2 || len(meta.CollectionItems) != 2 || len(results) != 2 {
+		t.Fatalf("expected 2 items, got count %d, %d items and %d results", meta.CollectionCount, len(meta.CollectionItems), len(results))
+	}
+	if item := meta.CollectionItems[0]; hex.EncodeToString(item.TxHash) != hex.EncodeToString(channelTxHash) || item.GetClaim() != nil {
+		t.Errorf("expected a reference to the channel, got %v", item)
+	}
+	if code := meta.CollectionItems[1].GetError().GetCode(); code != pb.Error_NOT_FOUND {
+		t.Errorf("expected the missing claim to be NOT_FOUND, got %v", meta.CollectionItems[1])
+	}
+	if len(extraTxos) != 1 || extraTxos[0].GetClaim() == nil {
+		t.Errorf("expected the channel output in the extra txos, got %v", extraTxos)
+	}
+
+	txo = newCollection()
+	_, extraTxos, err = expandCollection(txo, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta := txo.GetClaim(); meta.CollectionCount != 2 || len(meta.CollectionItems) != 1 || meta.CollectionItems[0].GetError() == nil {
+		t.Errorf("expected the second item only, got %v", meta)
+	}
+	if len(extraTxos) != 0 {
+		t.Errorf("expected no extra txos, got %v", extraTxos)
+	}
+
+	// The channel censors itself, so the censoring channel is resolvable.
+	hubServer.DB.BlockedChannels[string(channelHash)] = channelHash
+	txo = newCollection()
+	results, extraTxos, err = expandCollection(txo, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	item := txo.GetClaim().CollectionItems[0]
+	if item.GetError().GetCode() != pb.Error_BLOCKED || item.GetError().GetBlocked().GetChannel() == nil {
+		t.Errorf("expected the channel to be blocked, got %v", item)
+	}
+	if len(results) != 1 || results[0].Channel.GetError() == nil || results[0].Channel.GetError().CensorHash == nil {
+		t.Errorf("expected a censored result, got %v", results)
+	}
+	if len(extraTxos) != 1 {
+		t.Errorf("expected the censoring channel in the extra txos, got %v", extraTxos)
+	}
+
+	// A claim whose tx is missing is an error, not a claim that isn't a
+	// collection.
+	missing := &pb.Output{TxHash: make([]byte, 32), Meta: &pb.Output_Claim{Claim: &pb.ClaimMeta{}}}
+	if _, _, err := expandCollection(missing, 0, 0); err == nil {
+		t.Errorf("expected an error for a missing tx")
+	}
+}
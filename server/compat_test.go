@@ -0,0 +1,229 @@
+package server_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lbryio/herald/db"
+	"github.com/lbryio/herald/db/dbtest"
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"github.com/olivere/elastic/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// openTestDB opens a db filled with the rows of a testdata csv file, like
+// the db tests do.
+func openTestDB(t *testing.T, filePath string) *db.ReadOnlyDBColumnFamily {
+	res, _, toDefer, err := dbtest.OpenAndFillTmpDBColumnFamlies(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(toDefer)
+	return res
+}
+
+// esFixtureServer serves searches and mgets of the documents in a testdata
+// json file, the way the es writer indexed them.
+func esFixtureServer(t *testing.T, filePath string) *httptest.Server {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var docs []map[string]interface{}
+	if err := json.Unmarshal(data, &docs); err != nil {
+		t.Fatal(err)
+	}
+	byId := make(map[string]map[string]interface{})
+	for _, doc := range docs {
+		byId[doc["claim_id"].(string)] = doc
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp interface{}
+		if strings.HasSuffix(r.URL.Path, "/_mget") {
+			var body struct {
+				Docs []struct {
+					Id    string `json:"_id"`
+					Index string `json:"_index"`
+				} `json:"docs"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			results := make([]map[string]interface{}, 0, len(body.Docs))
+			for _, d := range body.Docs {
+				doc, ok := byId[d.Id]
+				results = append(results, map[string]interface{}{"_index": d.Index, "_id": d.Id, "found": ok, "_source": doc})
+			}
+			resp = map[string]interface{}{"docs": results}
+		} else if strings.HasSuffix(r.URL.Path, "/_search") {
+			// The tests only search for claims by id, a document matches
+			// if its claim id is in the query.
+			query, _ := ioutil.ReadAll(r.Body)
+			hits := make([]map[string]interface{}, 0, len(docs))
+			for _, doc := range docs {
+				if !strings.Contains(string(query), doc["claim_id"].(string)) {
+					continue
+				}
+				hits = append(hits, map[string]interface{}{"_index": "claims", "_id": doc["claim_id"], "_source": doc})
+			}
+			resp = map[string]interface{}{
+				"took": 1,
+				"hits": map[string]interface{}{"total": map[string]interface{}{"value": len(hits), "relation": "eq"}, "hits": hits},
+			}
+		} else {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+// txoReference returns the tx hash, nout and height of an output.
+func txoReference(txo *pb.Output) string {
+	if txo == nil {
+		return ""
+	}
+	return fmt.Sprintf("%x:%d:%d", txo.TxHash, txo.Nout, txo.Height)
+}
+
+// compareClaims checks that a resolved output and the output search returned
+// for the same claim are the same, field for field.
+func compareClaims(t *testing.T, got, want *pb.Output) {
+	t.Helper()
+	if txoReference(got) != txoReference(want) {
+		t.Errorf("resolve returned txo %s, search returned %s", txoReference(got), txoReference(want))
+	}
+	if got.GetClaim() == nil || want.GetClaim() == nil {
+		t.Fatalf("expected claim meta, got %v and %v", got, want)
+	}
+	// Search sends full channel and repost outputs, resolve only sends
+	// references to them.
+	gotMeta := proto.Clone(got.GetClaim()).(*pb.ClaimMeta)
+	wantMeta := proto.Clone(want.GetClaim()).(*pb.ClaimMeta)
+	if txoReference(gotMeta.Channel) != txoReference(wantMeta.Channel) {
+		t.Errorf("resolve returned channel %s, search returned %s", txoReference(gotMeta.Channel), txoReference(wantMeta.Channel))
+	}
+	if txoReference(gotMeta.Repost) != txoReference(wantMeta.Repost) {
+		t.Errorf("resolve returned repost %s, search returned %s", txoReference(gotMeta.Repost), txoReference(wantMeta.Repost))
+	}
+	gotMeta.Channel, gotMeta.Repost = nil, nil
+	wantMeta.Channel, wantMeta.Repost = nil, nil
+	if !proto.Equal(gotMeta, wantMeta) {
+		t.Errorf("claim meta differs\nresolve: %v\nsearch:  %v", gotMeta, wantMeta)
+	}
+}
+
+// TestResolveSearchCompat tests that resolving a claim and searching for it
+// by claim id return the same outputs, field for field. The documents are
+// the ones the es writer indexes for the claims in the test db.
+func TestResolveSearchCompat(t *testing.T) {
+	blockerHash, _ := hex.DecodeString("a41668b3d5f5412f153ca96708b80b57e3bf0663")
+	blockedHash, _ := hex.DecodeString("df88b84d816d3358b3793a61b73d80e93913e627")
+	tests := []struct {
+		name     string
+		url      string
+		claimId  string
+		censored bool
+	}{
+		{"channel", "lbry://@Compat#8", "808bab84eee8544ab60297a998489967294a4590", false},
+		{"stream", "lbry://@Compat#8/Kittens", "7aa4805b2523745dec81dafdcfa38a549242b347", false},
+		{"repost", "lbry://kittens-repost#9b6e", "9b6efcb692238cca5175ef54d5b26d49afd7fa04", false},
+		{"censored", "lbry://blocked#df88", "df88b84d816d3358b3793a61b73d80e93913e627", true},
+	}
+
+	ts := esFixtureServer(t, "../testdata/ES_compat.json")
+	defer ts.Close()
+	client, err := elastic.NewClient(elastic.SetURL(ts.URL), elastic.SetSniff(false), elastic.SetHealthcheck(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	args := makeDefaultArgs()
+	hubServer := server.MakeHubServer(ctx, args)
+	hubServer.Args.DisableEs = false
+	hubServer.EsClient = client
+	hubServer.DB = openTestDB(t, "../testdata/FULL_compat.csv")
+	hubServer.DB.Height = 10
+	hubServer.DB.BlockedStreams[string(blockedHash)] = blockerHash
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, err := hubServer.Resolve(ctx, &pb.ResolveRequest{Value: []string{tt.url}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			searched, err := hubServer.Search(ctx, &pb.SearchRequest{ClaimId: &pb.InvertibleField{Value: []string{tt.claimId}}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resolved.Txos) != 1 {
+				t.Fatalf("expected 1 txo from resolve, got %d", len(resolved.Txos))
+			}
+
+			if tt.censored {
+				// Search leaves censored claims out, resolve returns an error
+				// in their place. Both report the censoring channel.
+				if resolved.Txos[0].GetError().GetCode() != pb.Error_BLOCKED {
+					t.Errorf("expected resolve to be blocked, got %v", resolved.Txos[0])
+				}
+				if len(searched.Txos) != 0 {
+					t.Errorf("expected search to leave out the claim, got %v", searched.Txos)
+				}
+				if len(resolved.Blocked) != 1 || len(searched.Blocked) != 1 {
+					t.Fatalf("expected 1 blocked from resolve and search, got %v and %v", resolved.Blocked, searched.Blocked)
+				}
+				if resolved.BlockedTotal != searched.BlockedTotal || resolved.Blocked[0].Count != searched.Blocked[0].Count {
+					t.Errorf("resolve blocked %d claims, search blocked %d", resolved.BlockedTotal, searched.BlockedTotal)
+				}
+				compareClaims(t, resolved.Blocked[0].Channel, searched.Blocked[0].Channel)
+				return
+			}
+
+			if len(searched.Txos) != 1 {
+				t.Fatalf("expected 1 txo from search, got %d", len(searched.Txos))
+			}
+			compareClaims(t, resolved.Txos[0], searched.Txos[0])
+		})
+	}
+}
+
+// TestResolveAtHeight tests resolving a name at a past height, and that
+// heights outside the undo records fail with OutOfRange.
+func TestResolveAtHeight(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/M_history.csv")
+	hubServer.DB.Height = 10
+
+	// The name was taken over at height 10, before that it resolved to the
+	// claim in tx 4, whose hash in the test data starts with its tx num.
+	out, err := hubServer.Resolve(ctx, &pb.ResolveRequest{Value: []string{"lbry://test"}, Height: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Txos) != 1 || out.Txos[0].GetClaim() == nil {
+		t.Fatalf("expected 1 claim, got %v", out.Txos)
+	}
+	if out.Txos[0].TxHash[0] != 4 || out.Txos[0].GetClaim().TakeOverHeight != 4 {
+		t.Errorf("expected the claim in tx 4 taken over at height 4, got %v", out.Txos[0])
+	}
+	if len(out.ExtraTxos) != 1 || out.ExtraTxos[0].TxHash[0] != 3 {
+		t.Errorf("expected the channel in tx 3, got %v", out.ExtraTxos)
+	}
+
+	_, err = hubServer.Resolve(ctx, &pb.ResolveRequest{Value: []string{"lbry://test"}, Height: 7})
+	if status.Code(err) != codes.OutOfRange {
+		t.Errorf("expected OutOfRange, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+// expirations.go contains the endpoint listing the claims expiring at
+// upcoming heights.
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultExpirationsLimit is the number of claims returned if the request
+	// doesn't set a limit, and MaxExpirationsLimit the most.
+	DefaultExpirationsLimit = 50
+	MaxExpirationsLimit     = 500
+)
+
+// Expirations is a grpc endpoint that lists a page of the claims expiring in
+// a range of heights, with the number of blocks until each expires.
+func (s *Server) Expirations(ctx context.Context, in *pb.ExpirationsRequest) (*pb.ExpirationsResponse, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "expirations"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "expirations"}).
+			Observe(delta)
+	}(time.Now())
+
+	var channelHash []byte
+	if in.ChannelId != "" {
+		var err error
+		channelHash, err = parseClaimId(in.ChannelId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "expirations are unavailable")
+	}
+	minHeight := in.MinHeight
+	if minHeight == 0 {
+		minHeight = s.DB.Height + 1
+	}
+	if in.MaxHeight < minHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "max height %d is below min height %d", in.MaxHeight, minHeight)
+	}
+
+	limit := int(in.Limit)
+	if limit == 0 {
+		limit = DefaultExpirationsLimit
+	} else if limit > MaxExpirationsLimit {
+		limit = MaxExpirationsLimit
+	}
+	expirations, err := s.DB.GetExpirations(minHeight, in.MaxHeight, channelHash, int(in.Offset), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ExpirationsResponse{
+		Claims: make([]*pb.ClaimExpiration, 0, len(expirations)),
+	}
+	for _, expiration := range expirations {
+		txHash, err := s.DB.GetTxHash(expiration.TxNum)
+		if err != nil {
+			return nil, err
+		}
+		var blocksUntilExpiry uint32
+		if expiration.ExpirationHeight > s.DB.Height {
+			blocksUntilExpiry = expiration.ExpirationHeight - s.DB.Height
+		}
+		res.Claims = append(res.Claims, &pb.ClaimExpiration{
+			ClaimId:           hex.EncodeToString(expiration.ClaimHash),
+			NormalizedName:    expiration.NormalizedName,
+			TxHash:            txHash,
+			Nout:              uint32(expiration.Position),
+			Height:            expiration.Height,
+			ExpirationHeight:  expiration.ExpirationHeight,
+			BlocksUntilExpiry: blocksUntilExpiry,
+		})
+	}
+	return res, nil
+}
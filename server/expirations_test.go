@@ -0,0 +1,57 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestExpirations tests listing the claims expiring in a range of heights.
+// The test data has claims in txs 2 to 5 expiring at heights 262975 to
+// 262978, and the claims in txs 2 and 4 are in channel cc.
+func TestExpirations(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/O_expirations.csv")
+	hubServer.DB.Height = 262970
+
+	res, err := hubServer.Expirations(ctx, &pb.ExpirationsRequest{MaxHeight: 262977})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Claims) != 3 {
+		t.Fatalf("expected 3 claims, got %v", res.Claims)
+	}
+	for i, claim := range res.Claims {
+		if claim.TxHash[0] != byte(i+2) || claim.BlocksUntilExpiry != uint32(i+5) {
+			t.Errorf("expected the claim in tx %d to expire in %d blocks, got %v", i+2, i+5, claim)
+		}
+	}
+
+	res, err = hubServer.Expirations(ctx, &pb.ExpirationsRequest{MaxHeight: 300000, ChannelId: "cccccccccccccccccccccccccccccccccccccccc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Claims) != 2 || res.Claims[0].ClaimId != "0202020202020202020202020202020202020202" || res.Claims[1].NormalizedName != "claim-4" {
+		t.Errorf("expected the claims in txs 2 and 4, got %v", res.Claims)
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.ExpirationsRequest
+	}{
+		{"max below min", &pb.ExpirationsRequest{MinHeight: 262980, MaxHeight: 262975}},
+		{"max below the next block", &pb.ExpirationsRequest{}},
+		{"bad channel id", &pb.ExpirationsRequest{MaxHeight: 300000, ChannelId: "cc"}},
+	}
+	for _, tt := range tests {
+		_, err := hubServer.Expirations(ctx, tt.req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", tt.name, err)
+		}
+	}
+}
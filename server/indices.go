@@ -0,0 +1,91 @@
+package server
+
+// indices.go contains the handling of the es indices searched, which can be a
+// list of indices or aliases, and can be overridden per request.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/olivere/elastic/v7"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ParseIndices splits a comma separated list of es indices or aliases.
+func ParseIndices(indices string) []string {
+	res := make([]string, 0, 1)
+	for _, index := range strings.Split(indices, ",") {
+		if index = strings.TrimSpace(index); index != "" {
+			res = append(res, index)
+		}
+	}
+	return res
+}
+
+// defaultIndices returns the indices or aliases searched by default.
+func (s *Server) defaultIndices() []string {
+	return ParseIndices(s.Args.EsIndex)
+}
+
+// searchIndices returns the indices a search request should search, the
+// default indices unless the request overrides them with indices that are
+// allowed to be overridden to.
+func (s *Server) searchIndices(in *pb.SearchRequest) ([]string, error) {
+	if len(in.Index) == 0 {
+		return s.defaultIndices(), nil
+	}
+	allowed := make(map[string]bool, len(s.Args.EsOverrideIndices))
+	for _, index := range s.Args.EsOverrideIndices {
+		allowed[index] = true
+	}
+	for _, index := range in.Index {
+		if !allowed[index] {
+			return nil, fmt.Errorf("index %s can't be searched", index)
+		}
+	}
+	return in.Index, nil
+}
+
+// mgetRecords gets the documents with the given ids from the first of the
+// indices they're found in, in the order of ids. Duplicate ids and ids that
+// aren't found are left out.
+func mgetRecords(ctx context.Context, client *elastic.Client, ids []string, indices []string) ([]*record, error) {
+	mget := client.Mget()
+	for _, id := range ids {
+		for _, index := range indices {
+			mget = mget.Add(elastic.NewMultiGetItem().Id(id).Index(index))
+		}
+	}
+	res, err := mget.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]*record, len(ids))
+	for _, doc := range res.Docs {
+		if !doc.Found || doc.Source == nil || found[doc.Id] != nil {
+			continue
+		}
+		var r record
+		if err := json.Unmarshal(doc.Source, &r); err != nil {
+			metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "json"}).Inc()
+			return nil, err
+		}
+		r.index = doc.Index
+		found[doc.Id] = &r
+	}
+
+	records := make([]*record, 0, len(found))
+	added := make(map[string]bool, len(found))
+	for _, id := range ids {
+		if r, ok := found[id]; ok && !added[id] {
+			added[id] = true
+			records = append(records, r)
+		}
+	}
+	return records, nil
+}
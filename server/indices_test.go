@@ -0,0 +1,76 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"github.com/olivere/elastic/v7"
+)
+
+// TestParseIndices tests splitting a list of indices.
+func TestParseIndices(t *testing.T) {
+	got := server.ParseIndices(" claims, claims_staging,,")
+	want := []string{"claims", "claims_staging"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+// TestSearchIndices tests overriding the searched indices per request.
+func TestSearchIndices(t *testing.T) {
+	s := &server.Server{Args: &server.Args{EsIndex: "claims,claims_v2", EsOverrideIndices: []string{"claims_staging"}}}
+	searchIndices := s.SearchIndicesExported()
+
+	got, err := searchIndices(&pb.SearchRequest{})
+	if err != nil || !reflect.DeepEqual(got, []string{"claims", "claims_v2"}) {
+		t.Errorf("Expected default indices, got %v, %v", got, err)
+	}
+	got, err = searchIndices(&pb.SearchRequest{Index: []string{"claims_staging"}})
+	if err != nil || !reflect.DeepEqual(got, []string{"claims_staging"}) {
+		t.Errorf("Expected override index, got %v, %v", got, err)
+	}
+	if _, err = searchIndices(&pb.SearchRequest{Index: []string{"secret"}}); err == nil {
+		t.Error("Expected error for an index that can't be overridden to")
+	}
+}
+
+// TestMgetRecords tests getting documents from the first index they're found
+// in.
+func TestMgetRecords(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := `{"docs":[
+			{"_index":"a","_id":"x","found":false},
+			{"_index":"b","_id":"x","found":true,"_source":{"claim_id":"x","claim_name":"from b"}},
+			{"_index":"a","_id":"y","found":true,"_source":{"claim_id":"y","claim_name":"from a"}},
+			{"_index":"b","_id":"y","found":true,"_source":{"claim_id":"y","claim_name":"also b"}},
+			{"_index":"a","_id":"z","found":false},
+			{"_index":"b","_id":"z","found":false}
+		]}`
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(resp))
+	}))
+	defer ts.Close()
+
+	client, err := elastic.NewClient(elastic.SetURL(ts.URL), elastic.SetSniff(false), elastic.SetHealthcheck(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := server.MgetRecordsExported()(context.Background(), client, []string{"x", "y", "z"}, []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	if records[0].ClaimName != "from b" || records[0].IndexExported() != "b" {
+		t.Errorf("Expected x from b, got %+v", records[0])
+	}
+	if records[1].ClaimName != "from a" || records[1].IndexExported() != "a" {
+		t.Errorf("Expected y from a, got %+v", records[1])
+	}
+}
@@ -0,0 +1,82 @@
+package server
+
+// name_bids.go contains the endpoint listing the claims competing for a name.
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/lbryio/herald/internal"
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NameBids is a grpc endpoint that lists the claims competing for a name:
+// the controlling claim, the active claims by effective amount, and the
+// claims and supports for the name that haven't activated yet.
+func (s *Server) NameBids(ctx context.Context, in *pb.NameBidsRequest) (*pb.NameBidsResponse, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "name_bids"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "name_bids"}).
+			Observe(delta)
+	}(time.Now())
+
+	normalizedName := internal.NormalizeName(in.Name)
+	if normalizedName == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "name bids are unavailable")
+	}
+
+	bids, err := s.DB.GetNameBids(normalizedName)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.NameBidsResponse{
+		NormalizedName: bids.NormalizedName,
+		Bids:           make([]*pb.NameBid, 0, len(bids.Bids)),
+		Pending:        make([]*pb.PendingActivation, 0, len(bids.Pending)),
+	}
+	if bids.Controlling != nil {
+		res.ControllingClaimId = hex.EncodeToString(bids.Controlling.ClaimHash)
+		res.TakeoverHeight = bids.Controlling.Height
+	}
+	for _, bid := range bids.Bids {
+		txHash, err := s.DB.GetTxHash(bid.TxNum)
+		if err != nil {
+			return nil, err
+		}
+		res.Bids = append(res.Bids, &pb.NameBid{
+			ClaimId:          hex.EncodeToString(bid.ClaimHash),
+			TxHash:           txHash,
+			Nout:             uint32(bid.Position),
+			EffectiveAmount:  bid.EffectiveAmount,
+			ActivationHeight: bid.ActivationHeight,
+			IsControlling:    bid.IsControlling,
+		})
+	}
+	for _, pending := range bids.Pending {
+		txHash, err := s.DB.GetTxHash(pending.TxNum)
+		if err != nil {
+			return nil, err
+		}
+		res.Pending = append(res.Pending, &pb.PendingActivation{
+			ClaimId:          hex.EncodeToString(pending.ClaimHash),
+			TxHash:           txHash,
+			Nout:             uint32(pending.Position),
+			Amount:           pending.Amount,
+			ActivationHeight: pending.ActivationHeight,
+			IsSupport:        pending.IsSupport,
+		})
+	}
+	return res, nil
+}
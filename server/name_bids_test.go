@@ -0,0 +1,45 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestNameBids tests listing the claims competing for a name.
+func TestNameBids(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/Q_name_bids.csv")
+	hubServer.DB.Height = 10
+
+	res, err := hubServer.NameBids(ctx, &pb.NameBidsRequest{Name: "TEST"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.NormalizedName != "test" || res.ControllingClaimId != "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" || res.TakeoverHeight != 4 {
+		t.Errorf("expected test to be controlled by aa... since height 4, got %v", res)
+	}
+	if len(res.Bids) != 2 || !res.Bids[0].IsControlling || res.Bids[1].ClaimId != "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" {
+		t.Fatalf("expected the controlling bid then bb..., got %v", res.Bids)
+	}
+	// The tx hashes in the test data start with the tx num.
+	if res.Bids[1].TxHash[0] != 6 {
+		t.Errorf("expected the bid in tx 6, got %x", res.Bids[1].TxHash)
+	}
+	if len(res.Pending) != 2 || res.Pending[0].IsSupport || !res.Pending[1].IsSupport {
+		t.Fatalf("expected a pending claim then a pending support, got %v", res.Pending)
+	}
+	if res.Pending[1].TxHash[0] != 10 || res.Pending[1].Nout != 1 || res.Pending[1].Amount != 30 {
+		t.Errorf("expected the support at output 1 of tx 10 for 30, got %v", res.Pending[1])
+	}
+
+	_, err = hubServer.NameBids(ctx, &pb.NameBidsRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,300 @@
+package server
+
+// ranking.go contains the named ranking profiles that can be selected per
+// search request to wrap the es query in a function_score query.
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/olivere/elastic/v7"
+)
+
+// Score function types supported in a ranking profile.
+const (
+	RankingFuncFieldValueFactor = "field_value_factor"
+	RankingFuncDecay            = "decay"
+	RankingFuncWeight           = "weight"
+)
+
+// RankingFilter restricts a score function to documents where Field matches
+// one of Values, i.e. to boost a list of reputable channels.
+type RankingFilter struct {
+	Field  string   `json:"field"`
+	Values []string `json:"values"`
+}
+
+// RankingFunction is one scoring function in a ranking profile.
+type RankingFunction struct {
+	Type     string         `json:"type"`
+	Field    string         `json:"field"`
+	Weight   float64        `json:"weight"`
+	Factor   float64        `json:"factor"`
+	Modifier string         `json:"modifier"`
+	Missing  *float64       `json:"missing"`
+	Decay    string         `json:"decay"`
+	Origin   interface{}    `json:"origin"`
+	Scale    interface{}    `json:"scale"`
+	Offset   interface{}    `json:"offset"`
+	DecayVal float64        `json:"decay_value"`
+	Filter   *RankingFilter `json:"filter"`
+}
+
+// RankingProfile describes how to combine text relevance with the score
+// functions.
+type RankingProfile struct {
+	ScoreMode string             `json:"score_mode"`
+	BoostMode string             `json:"boost_mode"`
+	MaxBoost  float64            `json:"max_boost"`
+	Functions []*RankingFunction `json:"functions"`
+}
+
+// RankingProfiles holds all the profiles loaded from the config file.
+type RankingProfiles struct {
+	Default  string                     `json:"default"`
+	Profiles map[string]*RankingProfile `json:"profiles"`
+
+	path    string
+	modTime time.Time
+	mut     sync.RWMutex
+}
+
+// NewRankingProfiles creates an empty set of ranking profiles backed by the
+// file at path.
+func NewRankingProfiles(path string) *RankingProfiles {
+	return &RankingProfiles{
+		Profiles: make(map[string]*RankingProfile),
+		path:     path,
+	}
+}
+
+// ParseRankingProfiles parses and validates a json ranking profile config.
+func ParseRankingProfiles(data []byte) (string, map[string]*RankingProfile, error) {
+	var parsed struct {
+		Default  string                     `json:"default"`
+		Profiles map[string]*RankingProfile `json:"profiles"`
+	}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return "", nil, err
+	}
+	if parsed.Profiles == nil {
+		parsed.Profiles = make(map[string]*RankingProfile)
+	}
+	if _, ok := parsed.Profiles[parsed.Default]; parsed.Default != "" && !ok {
+		return "", nil, fmt.Errorf("default ranking profile %s not defined", parsed.Default)
+	}
+	for name, profile := range parsed.Profiles {
+		for _, fn := range profile.Functions {
+			switch fn.Type {
+			case RankingFuncFieldValueFactor:
+				if fn.Field == "" {
+					return "", nil, fmt.Errorf("profile %s: field_value_factor needs a field", name)
+				}
+			case RankingFuncDecay:
+				if fn.Field == "" || fn.Scale == nil {
+					return "", nil, fmt.Errorf("profile %s: decay needs a field and a scale", name)
+				}
+				if fn.Decay != "gauss" && fn.Decay != "exp" && fn.Decay != "linear" {
+					return "", nil, fmt.Errorf("profile %s: unknown decay function %s", name, fn.Decay)
+				}
+			case RankingFuncWeight:
+				if fn.Weight == 0 {
+					return "", nil, fmt.Errorf("profile %s: weight function needs a weight", name)
+				}
+			default:
+				return "", nil, fmt.Errorf("profile %s: unknown function type %s", name, fn.Type)
+			}
+		}
+	}
+	return parsed.Default, parsed.Profiles, nil
+}
+
+// Load reads the config file if it has been modified since the last load.
+// Returns true if the profiles changed.
+func (r *RankingProfiles) Load() (bool, error) {
+	if r.path == "" {
+		return false, nil
+	}
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return false, err
+	}
+	r.mut.RLock()
+	unchanged := info.ModTime().Equal(r.modTime)
+	r.mut.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	data, err := ioutil.ReadFile(r.path)
+	if err != nil {
+		return false, err
+	}
+	def, profiles, err := ParseRankingProfiles(data)
+	if err != nil {
+		return false, err
+	}
+
+	r.mut.Lock()
+	r.Default = def
+	r.Profiles = profiles
+	r.modTime = info.ModTime()
+	r.mut.Unlock()
+
+	return true, nil
+}
+
+// Get returns the profile with the given name, or the default profile if
+// name is empty. Returns an error for an unknown name.
+func (r *RankingProfiles) Get(name string) (*RankingProfile, error) {
+	r.mut.RLock()
+	defer r.mut.RUnlock()
+	if name == "" {
+		name = r.Default
+	}
+	if name == "" {
+		return nil, nil
+	}
+	profile, ok := r.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown ranking profile: %s", name)
+	}
+	return profile, nil
+}
+
+// scoreFunction turns a RankingFunction into an es score function.
+func (fn *RankingFunction) scoreFunction() elastic.ScoreFunction {
+	switch fn.Type {
+	case RankingFuncFieldValueFactor:
+		f := elastic.NewFieldValueFactorFunction().Field(fn.Field)
+		if fn.Factor != 0 {
+			f = f.Factor(fn.Factor)
+		}
+		if fn.Modifier != "" {
+			f = f.Modifier(fn.Modifier)
+		}
+		if fn.Missing != nil {
+			f = f.Missing(*fn.Missing)
+		}
+		if fn.Weight != 0 {
+			f = f.Weight(fn.Weight)
+		}
+		return f
+	case RankingFuncDecay:
+		// Timestamps like release_time are stored as unix seconds, so default
+		// the origin to the current time.
+		var origin interface{} = fn.Origin
+		if origin == nil {
+			origin = time.Now().Unix()
+		}
+		switch fn.Decay {
+		case "exp":
+			f := elastic.NewExponentialDecayFunction().FieldName(fn.Field).Origin(origin).Scale(fn.Scale)
+			if fn.Offset != nil {
+				f = f.Offset(fn.Offset)
+			}
+			if fn.DecayVal != 0 {
+				f = f.Decay(fn.DecayVal)
+			}
+			if fn.Weight != 0 {
+				f = f.Weight(fn.Weight)
+			}
+			return f
+		case "linear":
+			f := elastic.NewLinearDecayFunction().FieldName(fn.Field).Origin(origin).Scale(fn.Scale)
+			if fn.Offset != nil {
+				f = f.Offset(fn.Offset)
+			}
+			if fn.DecayVal != 0 {
+				f = f.Decay(fn.DecayVal)
+			}
+			if fn.Weight != 0 {
+				f = f.Weight(fn.Weight)
+			}
+			return f
+		default:
+			f := elastic.NewGaussDecayFunction().FieldName(fn.Field).Origin(origin).Scale(fn.Scale)
+			if fn.Offset != nil {
+				f = f.Offset(fn.Offset)
+			}
+			if fn.DecayVal != 0 {
+				f = f.Decay(fn.DecayVal)
+			}
+			if fn.Weight != 0 {
+				f = f.Weight(fn.Weight)
+			}
+			return f
+		}
+	default: // RankingFuncWeight
+		return elastic.NewWeightFactorFunction(fn.Weight)
+	}
+}
+
+// Apply wraps the given query in a function_score query for this profile.
+func (p *RankingProfile) Apply(q elastic.Query) elastic.Query {
+	fsq := elastic.NewFunctionScoreQuery().Query(q)
+	for _, fn := range p.Functions {
+		if fn.Filter != nil {
+			filter := elastic.NewTermsQuery(fn.Filter.Field, StrArrToInterface(fn.Filter.Values)...)
+			fsq = fsq.Add(filter, fn.scoreFunction())
+		} else {
+			fsq = fsq.AddScoreFunc(fn.scoreFunction())
+		}
+	}
+	if p.ScoreMode != "" {
+		fsq = fsq.ScoreMode(p.ScoreMode)
+	}
+	if p.BoostMode != "" {
+		fsq = fsq.BoostMode(p.BoostMode)
+	}
+	if p.MaxBoost != 0 {
+		fsq = fsq.MaxBoost(p.MaxBoost)
+	}
+	return fsq
+}
+
+// applyRankingProfile wraps the bool query in the ranking profile requested
+// by the search request, if any.
+func (s *Server) applyRankingProfile(q *elastic.BoolQuery, in *pb.SearchRequest) (elastic.Query, error) {
+	if s.RankingProfiles == nil {
+		if in.RankingProfile != "" {
+			return nil, fmt.Errorf("unknown ranking profile: %s", in.RankingProfile)
+		}
+		return q, nil
+	}
+	profile, err := s.RankingProfiles.Get(in.RankingProfile)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		return q, nil
+	}
+	return profile.Apply(q), nil
+}
+
+// RunReloadRankingProfiles Go routine that runs continuously while the hub
+// is active to pick up changes to the ranking profile config without a
+// restart. The query cache is purged when the profiles change.
+func (s *Server) RunReloadRankingProfiles() {
+	go func() {
+		for {
+			// FIXME: Figure out best sleep interval
+			time.Sleep(time.Second * 10)
+			changed, err := s.RankingProfiles.Load()
+			if err != nil {
+				log.Println("Error reloading ranking profiles: ", err)
+				continue
+			}
+			if changed {
+				log.Println("Ranking profiles reloaded")
+				_ = s.QueryCache.Purge()
+			}
+		}
+	}()
+}
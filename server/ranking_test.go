@@ -0,0 +1,83 @@
+package server_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	server "github.com/lbryio/herald/server"
+	"github.com/olivere/elastic/v7"
+)
+
+const testRankingProfiles = `{
+	"default": "trending",
+	"profiles": {
+		"trending": {
+			"score_mode": "sum",
+			"boost_mode": "multiply",
+			"functions": [
+				{"type": "field_value_factor", "field": "trending_score", "modifier": "log1p", "missing": 0, "weight": 2},
+				{"type": "decay", "decay": "gauss", "field": "release_time", "origin": 1650000000, "scale": 604800, "decay_value": 0.5},
+				{"type": "weight", "weight": 1.5, "filter": {"field": "channel_id.keyword", "values": ["abcd"]}}
+			]
+		},
+		"relevance": {}
+	}
+}`
+
+// TestParseRankingProfiles tests parsing and validating a ranking profile
+// config.
+func TestParseRankingProfiles(t *testing.T) {
+	def, profiles, err := server.ParseRankingProfiles([]byte(testRankingProfiles))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if def != "trending" {
+		t.Errorf("Expected default trending, got %s", def)
+	}
+	if len(profiles) != 2 {
+		t.Errorf("Expected 2 profiles, got %d", len(profiles))
+	}
+
+	bad := []string{
+		`{"default": "missing", "profiles": {}}`,
+		`{"profiles": {"x": {"functions": [{"type": "nope"}]}}}`,
+		`{"profiles": {"x": {"functions": [{"type": "decay", "decay": "gauss", "field": "release_time"}]}}}`,
+		`{"profiles": {"x": {"functions": [{"type": "field_value_factor"}]}}}`,
+	}
+	for _, b := range bad {
+		if _, _, err := server.ParseRankingProfiles([]byte(b)); err == nil {
+			t.Errorf("Expected error for %s", b)
+		}
+	}
+}
+
+// TestRankingProfileApply tests wrapping a query in a function_score query.
+func TestRankingProfileApply(t *testing.T) {
+	_, profiles, err := server.ParseRankingProfiles([]byte(testRankingProfiles))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := profiles["trending"].Apply(elastic.NewBoolQuery())
+	src, err := q.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	for _, want := range []string{
+		`"function_score"`,
+		`"score_mode":"sum"`,
+		`"boost_mode":"multiply"`,
+		`"field_value_factor":{"field":"trending_score","missing":0,"modifier":"log1p"}`,
+		`"gauss":{"release_time":{"decay":0.5,"origin":1650000000,"scale":604800}}`,
+		`"filter":{"terms":{"channel_id.keyword":["abcd"]}}`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %s in %s", want, got)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package server
+
+// ratelimit.go contains per client rate limiting of the grpc endpoints. Each
+// client gets a token bucket and every request takes a number of tokens
+// estimated from how expensive it is to answer.
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// ApiKeyMetadataKey is the grpc metadata key a client can send its api key
+	// in.
+	ApiKeyMetadataKey = "x-api-key"
+	// RetryAfterMetadataKey is the grpc metadata key with the number of
+	// seconds a rate limited client should wait before retrying.
+	RetryAfterMetadataKey = "retry-after"
+	// RateLimitIdleTime is how long a client's bucket is kept after its last
+	// request.
+	RateLimitIdleTime = time.Minute * 10
+)
+
+// tokenBucket is the rate limit state of a single client.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// RateLimiter keeps a token bucket per client, identified by api key if the
+// client sends a known one, otherwise by ip.
+type RateLimiter struct {
+	Rate        float64
+	Burst       float64
+	ApiKeys     map[string]bool
+	ApiKeyRate  float64
+	ApiKeyBurst float64
+
+	buckets map[string]*tokenBucket
+	mut     sync.Mutex
+}
+
+// NewRateLimiter creates a rate limiter that allows rate tokens per second
+// with bursts of up to burst tokens per client. Clients with one of apiKeys
+// get apiKeyRate and apiKeyBurst instead.
+func NewRateLimiter(rate float64, burst int, apiKeys []string, apiKeyRate float64, apiKeyBurst int) *RateLimiter {
+	keys := make(map[string]bool, len(apiKeys))
+	for _, key := range apiKeys {
+		keys[key] = true
+	}
+	metrics.RateLimitRate.With(prometheus.Labels{"client_type": "ip"}).Set(rate)
+	metrics.RateLimitBurst.With(prometheus.Labels{"client_type": "ip"}).Set(float64(burst))
+	metrics.RateLimitRate.With(prometheus.Labels{"client_type": "api_key"}).Set(apiKeyRate)
+	metrics.RateLimitBurst.With(prometheus.Labels{"client_type": "api_key"}).Set(float64(apiKeyBurst))
+	return &RateLimiter{
+		Rate:        rate,
+		Burst:       float64(burst),
+		ApiKeys:     keys,
+		ApiKeyRate:  apiKeyRate,
+		ApiKeyBurst: float64(apiKeyBurst),
+		buckets:     make(map[string]*tokenBucket),
+	}
+}
+
+// limits returns the rate and burst for a client key.
+func (r *RateLimiter) limits(key string) (float64, float64) {
+	if r.ApiKeys[key] {
+		return r.ApiKeyRate, r.ApiKeyBurst
+	}
+	return r.Rate, r.Burst
+}
+
+// Allow takes cost tokens from the client's bucket at time now. If there
+// aren't enough tokens nothing is taken, and it returns false and how long
+// until there will be.
+func (r *RateLimiter) Allow(key string, cost float64, now time.Time) (bool, time.Duration) {
+	rate, burst := r.limits(key)
+	// A request can never cost more than a full bucket, or it'd never run.
+	cost = math.Min(cost, burst)
+
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	bucket, ok := r.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, last: now}
+		r.buckets[key] = bucket
+		metrics.RateLimitClients.Set(float64(len(r.buckets)))
+	}
+	if elapsed := now.Sub(bucket.last).Seconds(); elapsed > 0 {
+		bucket.tokens = math.Min(burst, bucket.tokens+elapsed*rate)
+		bucket.last = now
+	}
+	if bucket.tokens >= cost {
+		bucket.tokens -= cost
+		return true, 0
+	}
+	if rate <= 0 {
+		return false, RateLimitIdleTime
+	}
+	wait := (cost - bucket.tokens) / rate
+	return false, time.Duration(math.Ceil(wait*1000)) * time.Millisecond
+}
+
+// Prune removes the buckets of clients that haven't made a request since
+// before the given time.
+func (r *RateLimiter) Prune(before time.Time) {
+	r.mut.Lock()
+	defer r.mut.Unlock()
+	for key, bucket := range r.buckets {
+		if bucket.last.Before(before) {
+			delete(r.buckets, key)
+		}
+	}
+	metrics.RateLimitClients.Set(float64(len(r.buckets)))
+}
+
+// RunPruneRateLimits Go routine that runs continuously while the hub is
+// active to drop the buckets of idle clients.
+func (r *RateLimiter) RunPruneRateLimits() {
+	go func() {
+		for {
+			time.Sleep(RateLimitIdleTime)
+			r.Prune(time.Now().Add(-RateLimitIdleTime))
+		}
+	}()
+}
+
+// clientKey returns the key a client is rate limited by, its api key if it
+// sent a known one, otherwise its ip.
+func (r *RateLimiter) clientKey(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range md.Get(ApiKeyMetadataKey) {
+			if r.ApiKeys[key] {
+				return key
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// admit checks if a request with the given cost is allowed, and returns a
+// ResourceExhausted error with retry-after metadata if it isn't.
+func (r *RateLimiter) admit(ctx context.Context, method string, cost float64) error {
+	ok, wait := r.Allow(r.clientKey(ctx), cost, time.Now())
+	if ok {
+		metrics.RateLimitCost.With(prometheus.Labels{"method": method}).Add(cost)
+		return nil
+	}
+	metrics.RateLimitedCount.With(prometheus.Labels{"method": method}).Inc()
+	retryAfter := strconv.Itoa(int(math.Ceil(wait.Seconds())))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadataKey, retryAfter)); err != nil {
+		log.Println("Error setting retry-after header: ", err)
+	}
+	return status.Error(codes.ResourceExhausted, fmt.Sprintf("rate limit exceeded, retry after %s seconds", retryAfter))
+}
+
+// UnaryServerInterceptor is a grpc interceptor that rate limits unary calls.
+func (r *RateLimiter) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := r.admit(ctx, info.FullMethod, EstimateRequestCost(req)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor is a grpc interceptor that rate limits opening
+// streams.
+func (r *RateLimiter) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := r.admit(ss.Context(), info.FullMethod, 1); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// EstimateRequestCost estimates how expensive a request is to answer in
+// tokens. Cheap requests cost 1.
+func EstimateRequestCost(req interface{}) float64 {
+	var cost float64 = 1
+	switch in := req.(type) {
+	case *pb.SearchRequest:
+		pageSize := 10
+		if in.Limit > 0 {
+			pageSize = int(in.Limit)
+		}
+		cost += float64(pageSize) / 10
+		numIds := len(in.AnyTags) + len(in.AllTags) + len(in.NotTags)
+		if in.ClaimId != nil {
+			numIds += len(in.ClaimId.Value)
+		}
+		if in.ChannelId != nil {
+			numIds += len(in.ChannelId.Value)
+		}
+		cost += float64(numIds) / 100
+		if in.Text != "" {
+			cost += 2
+		}
+		if in.LimitClaimsPerChannel > 0 {
+			cost += 2
+		}
+	case *pb.ResolveRequest:
+		cost += float64(len(in.Value)) / 2
+		if in.Height > 0 {
+			// Historical resolves read the undo records back to the height.
+			cost += 2
+		}
+		if in.ExpandCollections {
+			// Each url can be a collection with a page of claims.
+			pageSize := DefaultCollectionLimit
+			if in.CollectionLimit > 0 {
+				pageSize = int(in.CollectionLimit)
+			}
+			cost += float64(pageSize) / 10 * float64(len(in.Value))
+		}
+	case *pb.RelatedRequest:
+		pageSize := 10
+		if in.Limit > 0 {
+			pageSize = int(in.Limit)
+		}
+		cost += 2 + float64(pageSize)/10
+	case *pb.ListSupportsRequest:
+		pageSize := DefaultSupportsLimit
+		if in.Limit > 0 {
+			pageSize = int(in.Limit)
+		}
+		cost += float64(pageSize) / 10
+	case *pb.ChannelClaimsRequest:
+		pageSize := DefaultChannelClaimsLimit
+		if in.Limit > 0 {
+			pageSize = int(in.Limit)
+		}
+		cost += float64(pageSize) / 10
+		if len(in.ClaimType) > 0 {
+			// Filtering by type decodes every claim in the channel.
+			cost += 2
+		}
+	case *pb.ListRepostsRequest:
+		pageSize := DefaultRepostsLimit
+		if in.Limit > 0 {
+			pageSize = int(in.Limit)
+		}
+		cost += float64(pageSize) / 10
+	case *pb.ExpirationsRequest:
+		pageSize := DefaultExpirationsLimit
+		if in.Limit > 0 {
+			pageSize = int(in.Limit)
+		}
+		cost += float64(pageSize) / 10
+		if in.ChannelId != "" {
+			// Filtering by channel looks up the channel of every claim.
+			cost += 2
+		}
+	case *pb.UpcomingTakeoversRequest:
+		// Every name with a pending bid in the window is simulated.
+		cost += 4
+	}
+	return cost
+}
@@ -0,0 +1,103 @@
+package server_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// TestRateLimiterAllow tests taking tokens from a client's bucket and
+// refilling it over time.
+func TestRateLimiterAllow(t *testing.T) {
+	r := server.NewRateLimiter(1, 5, []string{"secret"}, 10, 50)
+	now := time.Unix(1650000000, 0)
+
+	if ok, _ := r.Allow("1.2.3.4", 5, now); !ok {
+		t.Error("Expected a full bucket to allow the request")
+	}
+	ok, wait := r.Allow("1.2.3.4", 2, now)
+	if ok {
+		t.Error("Expected an empty bucket to reject the request")
+	}
+	if wait != time.Second*2 {
+		t.Errorf("Expected to wait 2s, got %v", wait)
+	}
+	if ok, _ := r.Allow("1.2.3.4", 2, now.Add(time.Second*2)); !ok {
+		t.Error("Expected the bucket to refill")
+	}
+	// Other clients have their own bucket.
+	if ok, _ := r.Allow("5.6.7.8", 1, now); !ok {
+		t.Error("Expected another client to be allowed")
+	}
+	// Api keys get the api key limits.
+	if ok, _ := r.Allow("secret", 50, now); !ok {
+		t.Error("Expected the api key burst to allow the request")
+	}
+	// Requests costing more than the burst are capped to the burst.
+	if ok, _ := r.Allow("9.9.9.9", 100, now); !ok {
+		t.Error("Expected an expensive request to be capped to the burst")
+	}
+
+	r.Prune(now.Add(time.Second))
+	if ok, _ := r.Allow("9.9.9.9", 5, now.Add(time.Second)); !ok {
+		t.Error("Expected a pruned client to get a new bucket")
+	}
+}
+
+// TestEstimateRequestCost tests that bigger requests cost more.
+func TestEstimateRequestCost(t *testing.T) {
+	small := server.EstimateRequestCost(&pb.SearchRequest{})
+	big := server.EstimateRequestCost(&pb.SearchRequest{
+		Text:                  "cats",
+		Limit:                 50,
+		LimitClaimsPerChannel: 2,
+		ClaimId:               &pb.InvertibleField{Value: make([]string, 200)},
+	})
+	if big <= small {
+		t.Errorf("Expected big search to cost more than %f, got %f", small, big)
+	}
+	if got := server.EstimateRequestCost(&pb.ResolveRequest{Value: make([]string, 10)}); got != 6 {
+		t.Errorf("Expected resolving 10 urls to cost 6, got %f", got)
+	}
+	if got := server.EstimateRequestCost(&pb.ResolveRequest{Value: make([]string, 10), ExpandCollections: true, CollectionLimit: 20}); got != 26 {
+		t.Errorf("Expected expanding 10 urls with 20 claims each to cost 26, got %f", got)
+	}
+	if got := server.EstimateRequestCost(&pb.EmptyMessage{}); got != 1 {
+		t.Errorf("Expected default cost 1, got %f", got)
+	}
+}
+
+// TestRateLimiterInterceptor tests that the interceptor rejects requests
+// over the limit with ResourceExhausted.
+func TestRateLimiterInterceptor(t *testing.T) {
+	r := server.NewRateLimiter(0.001, 1, []string{"secret"}, 0.001, 100)
+	addr := &net.TCPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 1234}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.Hub/Ping"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.StringValue{Value: "Hello, world!"}, nil
+	}
+
+	if _, err := r.UnaryServerInterceptor(ctx, &pb.EmptyMessage{}, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	_, err := r.UnaryServerInterceptor(ctx, &pb.EmptyMessage{}, info, handler)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected ResourceExhausted, got %v", err)
+	}
+
+	// Same ip with a known api key uses the api key's bucket.
+	keyCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(server.ApiKeyMetadataKey, "secret"))
+	if _, err := r.UnaryServerInterceptor(keyCtx, &pb.EmptyMessage{}, info, handler); err != nil {
+		t.Errorf("Expected api key client to be allowed, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package server
+
+// refresh.go contains the background watcher that tracks when the es index
+// has been refreshed, so the query cache can be keyed on the index generation
+// instead of searches checking the index stats themselves.
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// MinRefreshInterval is the shortest time between polls of the index stats.
+const MinRefreshInterval = time.Millisecond * 100
+
+// EsGeneration returns the current generation of the es index. It changes
+// every time the index has been refreshed, so cached results from an older
+// generation are never used.
+func (s *Server) EsGeneration() uint64 {
+	return atomic.LoadUint64(&s.esGeneration)
+}
+
+// bumpEsGeneration starts a new generation of the es index, and drops the
+// cached results of the old ones since they can't be used anymore.
+func (s *Server) bumpEsGeneration() {
+	atomic.AddUint64(&s.esGeneration, 1)
+	_ = s.QueryCache.Purge()
+}
+
+// queryCacheKey keys a serialized search request on the es generation.
+func (s *Server) queryCacheKey(serialized string) string {
+	return fmt.Sprintf("%d:%s", s.EsGeneration(), serialized)
+}
+
+// checkEsRefresh checks if the index has been refreshed, or the es sync height
+// in the db has moved, since the last check, and if so bumps the generation.
+func (s *Server) checkEsRefresh(ctx context.Context) error {
+	changed := false
+	if s.DB != nil && s.DB.LastState != nil {
+		esSyncHeight := s.DB.LastState.EsSyncHeight
+		if atomic.SwapUint32(&s.esSyncHeight, esSyncHeight) != esSyncHeight {
+			changed = true
+		}
+	}
+
+	// The stats are keyed by the concrete indices, so aliases are resolved
+	// and flipping an alias to another index counts as a refresh.
+	res, err := s.EsClient.IndexStats(s.defaultIndices()...).Do(ctx)
+	if err != nil {
+		if isEsOutage(ctx, err) {
+			s.EsBreaker.Failure(time.Now())
+		}
+		return err
+	}
+	// Passing index stats doesn't mean searches work, so only searches
+	// close the breaker.
+	var numRefreshes int64 = 0
+	names := make([]string, 0, len(res.Indices))
+	for name, stats := range res.Indices {
+		if stats.Primaries != nil && stats.Primaries.Refresh != nil {
+			numRefreshes += stats.Primaries.Refresh.Total
+		}
+		names = append(names, name)
+	}
+	if atomic.SwapInt64(&s.NumESRefreshes, numRefreshes) != numRefreshes {
+		changed = true
+	}
+	sort.Strings(names)
+	indices := strings.Join(names, ",")
+	if prev, _ := s.esStatsIndices.Swap(indices).(string); prev != indices {
+		changed = true
+	}
+
+	if changed {
+		s.bumpEsGeneration()
+	}
+	return nil
+}
+
+// RunEsRefreshWatcher Go routine that runs continuously while the hub is
+// active to keep track of es index refreshes. Polls every RefreshDelta.
+func (s *Server) RunEsRefreshWatcher(ctx context.Context) {
+	interval := s.RefreshDelta
+	if interval < MinRefreshInterval {
+		interval = MinRefreshInterval
+	}
+	go func() {
+		for {
+			if err := s.checkEsRefresh(ctx); err != nil {
+				log.Printf("Error on ES index stats\n%v\n", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+}
@@ -0,0 +1,70 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	server "github.com/lbryio/herald/server"
+	"github.com/olivere/elastic/v7"
+)
+
+// TestEsRefreshWatcher tests that the es generation is bumped in the
+// background when the index has been refreshed.
+func TestEsRefreshWatcher(t *testing.T) {
+	var refreshes int64 = 1
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := fmt.Sprintf(`{"indices":{"claims":{"primaries":{"refresh":{"total":%d}}}}}`, atomic.LoadInt64(&refreshes))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(resp))
+	}))
+	defer ts.Close()
+
+	client, err := elastic.NewClient(elastic.SetURL(ts.URL), elastic.SetSniff(false), elastic.SetHealthcheck(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	args := makeDefaultArgs()
+	hubServer := server.MakeHubServer(ctx, args)
+	hubServer.EsClient = client
+	hubServer.RefreshDelta = 0
+	hubServer.RunEsRefreshWatcher(ctx)
+
+	waitForGeneration := func(want uint64) {
+		deadline := time.Now().Add(time.Second * 5)
+		for hubServer.EsGeneration() != want {
+			if time.Now().After(deadline) {
+				t.Fatalf("Expected generation %d, got %d", want, hubServer.EsGeneration())
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+	}
+
+	waitForGeneration(1)
+	// Results of the old generation are dropped, and passing index stats
+	// don't close a breaker opened by failing searches.
+	hubServer.QueryCache.Set("1:cached", true)
+	for i := 0; i < hubServer.EsBreaker.Threshold; i++ {
+		hubServer.EsBreaker.Failure(time.Now())
+	}
+	atomic.StoreInt64(&refreshes, 2)
+	waitForGeneration(2)
+	if count := hubServer.QueryCache.Count(); count != 0 {
+		t.Errorf("Expected the query cache to be purged, got %d entries", count)
+	}
+	if state := hubServer.EsBreaker.State(); state != server.BreakerOpen {
+		t.Errorf("Expected the breaker to stay open, got %d", state)
+	}
+	// No refresh, no new generation.
+	time.Sleep(server.MinRefreshInterval * 3)
+	if got := hubServer.EsGeneration(); got != 2 {
+		t.Errorf("Expected generation to stay at 2, got %d", got)
+	}
+}
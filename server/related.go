@@ -0,0 +1,133 @@
+package server
+
+// related.go contains the "more like this" endpoint used for recommending
+// claims related to a given claim.
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/olivere/elastic/v7"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RelatedSearchSize is the max number of candidates the more_like_this query
+// returns before post processing.
+const RelatedSearchSize = 200
+
+// relatedFields are the text fields compared by the more_like_this query.
+var relatedFields = []string{"title", "description", "tags"}
+
+// Related is a grpc endpoint that returns claims similar to the given claim,
+// based on its title, description and tags. The claim itself and reposts of
+// it are never returned.
+func (s *Server) Related(ctx context.Context, in *pb.RelatedRequest) (*pb.Outputs, error) {
+	if s.Args.DisableEs {
+		log.Println("ElasticSearch disable, return nil to related")
+		return &pb.Outputs{}, nil
+	}
+
+	metrics.RequestsCount.With(prometheus.Labels{"method": "related"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "related"}).
+			Observe(delta)
+	}(time.Now())
+
+	if in.ClaimId == "" {
+		return nil, fmt.Errorf("claim_id can't be empty")
+	}
+
+	client := s.EsClient
+	searchIndices := s.defaultIndices()
+
+	sources, err := mgetRecords(ctx, client, []string{in.ClaimId}, searchIndices)
+	if err != nil {
+		metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "related"}).Inc()
+		log.Println("Error getting related source claim: ", err)
+		return nil, err
+	} else if len(sources) == 0 {
+		return nil, fmt.Errorf("claim %s not found", in.ClaimId)
+	}
+	source := sources[0]
+
+	fsc := elastic.NewFetchSourceContext(true).Exclude("description", "title")
+	searchResult, err := client.Search().
+		Index(searchIndices...).
+		FetchSourceContext(fsc).
+		Query(RelatedQuery(source.index, source.ClaimId, source.getHitId())).
+		From(0).Size(RelatedSearchSize).
+		Do(ctx)
+	if err != nil && elastic.IsNotFound(err) {
+		log.Println("Index returned 404! Check writer. Index: ", searchIndices)
+		return &pb.Outputs{}, nil
+	} else if err != nil {
+		metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "related"}).Inc()
+		log.Println("Error executing related query: ", err)
+		return nil, err
+	}
+
+	records := removeHitId(s.searchResultToRecords(searchResult), source.getHitId())
+
+	// Post process the same way as a search that removes duplicates, so a
+	// claim and its reposts only show up once.
+	searchRequest := &pb.SearchRequest{
+		Limit:                 in.Limit,
+		Offset:                in.Offset,
+		LimitClaimsPerChannel: in.LimitClaimsPerChannel,
+		RemoveDuplicates:      true,
+	}
+	var from = 0
+	var pageSize = 10
+	setPageVars(searchRequest, &pageSize, &from)
+	txos, extraTxos, blocked := s.postProcessResults(ctx, client, records, searchRequest, pageSize, from, searchIndices)
+
+	var blockedTotal uint32 = 0
+	for _, b := range blocked {
+		blockedTotal += b.Count
+	}
+	out := &pb.Outputs{
+		Txos:         txos,
+		ExtraTxos:    extraTxos,
+		Total:        uint32(len(records)),
+		Offset:       uint32(from + len(txos)),
+		Blocked:      blocked,
+		BlockedTotal: blockedTotal,
+	}
+	if in.IncludeClaimValues {
+		s.addClaimValues(out)
+	}
+	return out, nil
+}
+
+// RelatedQuery returns the more_like_this query for the claim with the given
+// id in index, excluding the claim and anything with the same hit id.
+func RelatedQuery(index string, claimId string, hitId string) *elastic.BoolQuery {
+	mlt := elastic.NewMoreLikeThisQuery().
+		Field(relatedFields...).
+		LikeItems(elastic.NewMoreLikeThisQueryItem().Index(index).Id(claimId)).
+		MinTermFreq(1).
+		MaxQueryTerms(25)
+	return elastic.NewBoolQuery().
+		Must(mlt).
+		MustNot(elastic.NewTermsQuery("claim_id.keyword", claimId, hitId)).
+		MustNot(elastic.NewTermQuery("reposted_claim_id.keyword", hitId))
+}
+
+// removeHitId takes an array of record results and removes the ones that are
+// or repost the claim with the given hit id.
+func removeHitId(searchHits []*record, hitId string) []*record {
+	newHits := make([]*record, 0, len(searchHits))
+	for _, r := range searchHits {
+		if r.getHitId() != hitId {
+			newHits = append(newHits, r)
+		}
+	}
+	return newHits
+}
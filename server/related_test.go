@@ -0,0 +1,32 @@
+package server_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	server "github.com/lbryio/herald/server"
+)
+
+// TestRelatedQuery tests the more_like_this query used for related claims.
+func TestRelatedQuery(t *testing.T) {
+	src, err := server.RelatedQuery("claims", "abcd", "1234").Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	for _, want := range []string{
+		`"fields":["title","description","tags"]`,
+		`"like":[{"_id":"abcd","_index":"claims"}]`,
+		`{"terms":{"claim_id.keyword":["abcd","1234"]}}`,
+		`{"term":{"reposted_claim_id.keyword":"1234"}}`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %s in %s", want, got)
+		}
+	}
+}
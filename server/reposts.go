@@ -0,0 +1,116 @@
+package server
+
+// reposts.go contains the endpoint listing the reposts of a claim.
+
+import (
+	"context"
+	"time"
+
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultRepostsLimit is the number of reposts returned if the request
+	// doesn't set a limit, and MaxRepostsLimit the most.
+	DefaultRepostsLimit = 20
+	MaxRepostsLimit     = 200
+	// MaxRepostsTotal is the most reposts counted in the total, the rest of
+	// the reposts of a popular claim aren't walked once the page is full.
+	MaxRepostsTotal = 1000
+)
+
+// ListReposts is a grpc endpoint that lists a page of the reposts of a
+// claim, newest first, with their channels in extra_txos. Reposts that are
+// blocked or filtered, or in a blocked or filtered channel, are counted in
+// blocked instead. The total stops at MaxRepostsTotal, or the end of the
+// page if it's further.
+func (s *Server) ListReposts(ctx context.Context, in *pb.ListRepostsRequest) (*pb.Outputs, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "list_reposts"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "list_reposts"}).
+			Observe(delta)
+	}(time.Now())
+
+	return s.listReposts(ctx, in, MaxRepostsTotal)
+}
+
+// listReposts lists a page of the reposts of a claim, counting at most
+// maxTotal reposts once the page is full.
+func (s *Server) listReposts(ctx context.Context, in *pb.ListRepostsRequest, maxTotal int) (*pb.Outputs, error) {
+	claimHash, err := parseClaimId(in.ClaimId)
+	if err != nil {
+		return nil, err
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "reposts are unavailable")
+	}
+
+	limit := int(in.Limit)
+	if limit == 0 {
+		limit = DefaultRepostsLimit
+	} else if limit > MaxRepostsLimit {
+		limit = MaxRepostsLimit
+	}
+	from := int(in.Offset)
+
+	// The index is ordered by tx num, so newest first in reverse.
+	it, err := s.DB.RepostedClaimsIter(ctx, claimHash, true)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "reposts are unavailable")
+	}
+	defer it.Close()
+	txos := make([]*pb.Output, 0, limit)
+	extraTxos := make([]*pb.Output, 0)
+	seenChannels := make(map[string]bool)
+	blocked := newBlockedClaims()
+	var total = 0
+	for it.Next() {
+		if len(txos) == limit && total >= maxTotal {
+			break
+		}
+		repostHash := it.Value().ClaimHash
+		isBlocked, err := s.addIfBlocked(blocked, repostHash)
+		if err != nil {
+			return nil, err
+		} else if isBlocked {
+			continue
+		}
+
+		// Only reposts on this page are resolved.
+		if total >= from && len(txos) < limit {
+			res, err := s.DB.FsGetClaimByHash(repostHash)
+			if err != nil {
+				return nil, err
+			}
+			txos = append(txos, res.ToOutput())
+			if len(res.ChannelHash) > 0 && !seenChannels[string(res.ChannelHash)] {
+				seenChannels[string(res.ChannelHash)] = true
+				channel, err := s.DB.FsGetClaimByHash(res.ChannelHash)
+				if err != nil {
+					return nil, err
+				}
+				extraTxos = append(extraTxos, channel.ToOutput())
+			}
+		}
+		total += 1
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return &pb.Outputs{
+		Txos:         txos,
+		ExtraTxos:    extraTxos,
+		Total:        uint32(total),
+		Offset:       uint32(from + len(txos)),
+		Blocked:      blocked.list(),
+		BlockedTotal: blocked.total,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestListReposts tests listing the reposts of a claim with their channels.
+// The test data has reposts of ee... in txs 2, 3 and 4, in channels cc...,
+// dd... and none, and the tx hashes start with the tx num.
+func TestListReposts(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/W_reposts.csv")
+	claimId := "eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"
+
+	out, err := hubServer.ListReposts(ctx, &pb.ListRepostsRequest{ClaimId: claimId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Total != 3 || len(out.Txos) != 3 {
+		t.Fatalf("expected 3 reposts, got %v", out)
+	}
+	for i, txNum := range []byte{4, 3, 2} {
+		if out.Txos[i].TxHash[0] != txNum {
+			t.Errorf("expected repost %d in tx %d, got %x", i, txNum, out.Txos[i].TxHash)
+		}
+	}
+	if len(out.ExtraTxos) != 2 || out.ExtraTxos[0].TxHash[0] != 7 || out.ExtraTxos[1].TxHash[0] != 1 {
+		t.Errorf("expected the channels in txs 7 and 1, got %v", out.ExtraTxos)
+	}
+	if out.Txos[1].GetClaim().GetChannel().GetTxHash()[0] != 7 {
+		t.Errorf("expected the repost in tx 3 to reference its channel, got %v", out.Txos[1])
+	}
+
+	// Once the page is full, reposts are only counted up to the max total.
+	listReposts := hubServer.ListRepostsExported()
+	out, err = listReposts(ctx, &pb.ListRepostsRequest{ClaimId: claimId, Limit: 1}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Total != 2 || len(out.Txos) != 1 || out.Txos[0].TxHash[0] != 4 {
+		t.Errorf("expected the repost in tx 4 of 2, got %v", out)
+	}
+
+	// Reposts in a blocked channel are counted in blocked.
+	hubServer.DB.BlockedChannels[string(bytes.Repeat([]byte{0xdd}, 20))] = bytes.Repeat([]byte{0xff}, 20)
+	out, err = hubServer.ListReposts(ctx, &pb.ListRepostsRequest{ClaimId: claimId, Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Total != 2 || len(out.Txos) != 1 || out.Txos[0].TxHash[0] != 2 {
+		t.Errorf("expected the repost in tx 2 of 2, got %v", out)
+	}
+	if out.BlockedTotal != 1 {
+		t.Errorf("expected 1 blocked repost, got %d", out.BlockedTotal)
+	}
+
+	_, err = hubServer.ListReposts(ctx, &pb.ListRepostsRequest{ClaimId: "ee"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,238 @@
+package server
+
+// resolve.go contains the resolve endpoint, which resolves a batch of urls
+// concurrently with a bounded pool of workers.
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/lbryio/herald/db"
+	"github.com/lbryio/herald/internal"
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Resolve is a grpc endpoint that resolves a list of urls. Duplicate urls,
+// including ones that only differ before normalization, are only resolved
+// once. The txos are in the same order as the urls. If a height is given the
+// names in the urls are resolved to the claims controlling them at that
+// height, as far back as the db keeps undo records.
+func (s *Server) Resolve(ctx context.Context, args *pb.ResolveRequest) (*pb.Outputs, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "resolve"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "resolve"}).
+			Observe(delta)
+	}(time.Now())
+
+	if s.Args.MaxResolveUrls > 0 && len(args.Value) > s.Args.MaxResolveUrls {
+		return nil, status.Errorf(codes.InvalidArgument, "too many urls, max is %d", s.Args.MaxResolveUrls)
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "resolve is unavailable")
+	}
+
+	resolve := s.DB.Resolve
+	historical := args.Height > 0 && args.Height != s.DB.Height
+	if historical {
+		state, err := s.DB.AtHeight(args.Height)
+		if errors.Is(err, db.ErrHeightOutOfWindow) {
+			return nil, status.Error(codes.OutOfRange, err.Error())
+		} else if err != nil {
+			return nil, err
+		}
+		resolve = state.Resolve
+		// Collections are expanded with the current state of their claims.
+		if args.ExpandCollections {
+			return nil, status.Error(codes.InvalidArgument, "collections can't be expanded at a past height")
+		}
+	}
+
+	results, err := resolveUrls(ctx, args.Value, s.Args.ResolveWorkers, resolve)
+	if err != nil {
+		return nil, err
+	}
+
+	allTxos := make([]*pb.Output, 0, len(results))
+	allExtraTxos := make([]*pb.Output, 0)
+	seenExtraTxos := make(map[string]bool)
+	// Urls in the same channel all have the channel as an extra txo, it only
+	// needs to be sent once.
+	addExtraTxos := func(extraTxos []*pb.Output) {
+		for _, txo := range extraTxos {
+			key := txoKey(txo.TxHash, txo.Nout)
+			if seenExtraTxos[key] {
+				continue
+			}
+			seenExtraTxos[key] = true
+			allExtraTxos = append(allExtraTxos, txo)
+		}
+	}
+	// Count the blocked claims by censoring channel, like search does.
+	blocked := newBlockedClaims()
+	for _, res := range results {
+		txos, extraTxos, err := res.ToOutputs()
+		if err != nil {
+			return nil, err
+		}
+		allTxos = append(allTxos, txos...)
+		addExtraTxos(extraTxos)
+		addIfResolveBlocked(blocked, res)
+	}
+
+	allResults := results
+	if args.ExpandCollections {
+		for _, txo := range allTxos {
+			itemResults, extraTxos, err := s.expandCollection(txo, args.CollectionOffset, args.CollectionLimit)
+			if err != nil {
+				return nil, err
+			}
+			addExtraTxos(extraTxos)
+			for _, res := range itemResults {
+				addIfResolveBlocked(blocked, res)
+			}
+			allResults = append(allResults, itemResults...)
+		}
+	}
+
+	out := &pb.Outputs{
+		Txos:         allTxos,
+		ExtraTxos:    allExtraTxos,
+		Total:        uint32(len(allTxos) + len(allExtraTxos)),
+		Offset:       uint32(len(allTxos)),
+		Blocked:      blocked.list(),
+		BlockedTotal: blocked.total,
+	}
+	// Trending scores are only known for the current height.
+	if !historical && !s.Args.DisableEs && s.EsClient != nil {
+		s.addTrendingScores(ctx, allResults, out)
+	}
+	if args.IncludeClaimValues {
+		s.addClaimValues(out)
+	}
+	return out, nil
+}
+
+// resolveUrls resolves each distinct url with resolve, using up to workers
+// goroutines, and returns the results in the same order as urls.
+func resolveUrls(ctx context.Context, urls []string, workers int, resolve func(string) *db.ExpandedResolveResult) ([]*db.ExpandedResolveResult, error) {
+	// Map each url to the first url with the same normalized form. Urls that
+	// don't parse are only deduplicated with identical ones, resolving them
+	// returns the parse error.
+	keys := make([]int, len(urls))
+	unique := make([]string, 0, len(urls))
+	seen := make(map[string]int)
+	for i, url := range urls {
+		key, err := db.NormalizeUrl(url)
+		if err != nil {
+			key = url
+		}
+		idx, ok := seen[key]
+		if !ok {
+			idx = len(unique)
+			seen[key] = idx
+			unique = append(unique, url)
+		}
+		keys[i] = idx
+	}
+
+	if workers < 1 {
+		workers = DefaultResolveWorkers
+	}
+	if workers > len(unique) {
+		workers = len(unique)
+	}
+
+	resolved := make([]*db.ExpandedResolveResult, len(unique))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				resolved[idx] = resolve(unique[idx])
+			}
+		}()
+	}
+
+queue:
+	for idx := range unique {
+		select {
+		case <-ctx.Done():
+			break queue
+		case jobs <- idx:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]*db.ExpandedResolveResult, len(urls))
+	for i, idx := range keys {
+		results[i] = resolved[idx]
+	}
+	return results, nil
+}
+
+// addTrendingScores sets the trending scores of the claims in out from es,
+// since they aren't kept in the db. If es is down the scores are left out.
+func (s *Server) addTrendingScores(ctx context.Context, results []*db.ExpandedResolveResult, out *pb.Outputs) {
+	ids := make([]string, 0, len(results))
+	for _, res := range results {
+		for _, x := range []db.OptionalResolveResultOrError{res.Stream, res.Channel, res.Repost, res.RepostedChannel} {
+			if x != nil && x.GetResult() != nil {
+				ids = append(ids, hex.EncodeToString(x.GetResult().ClaimHash))
+			}
+		}
+		if x := res.Channel.GetError(); x != nil && x.Censor != nil {
+			ids = append(ids, hex.EncodeToString(x.Censor.ClaimHash))
+		}
+	}
+	if len(ids) == 0 || !s.EsBreaker.Allow(time.Now()) {
+		return
+	}
+
+	records, err := mgetRecords(ctx, s.EsClient, ids, s.defaultIndices())
+	s.esDone(ctx, err)
+	if err != nil {
+		log.Println("Error getting trending scores: ", err)
+		return
+	}
+
+	scores := make(map[string]float64, len(records))
+	for _, r := range records {
+		scores[txoKey(internal.TxIdToTxHash(r.Txid), r.Nout)] = r.TrendingScore
+	}
+	censors := make([]*pb.Output, 0, len(out.Blocked))
+	for _, b := range out.Blocked {
+		if b.Channel != nil {
+			censors = append(censors, b.Channel)
+		}
+	}
+	for _, txos := range [][]*pb.Output{out.Txos, out.ExtraTxos, censors} {
+		for _, txo := range txos {
+			if meta := txo.GetClaim(); meta != nil {
+				meta.TrendingScore = scores[txoKey(txo.TxHash, txo.Nout)]
+			}
+		}
+	}
+}
+
+// txoKey returns a map key for a txo.
+func txoKey(txHash []byte, nout uint32) string {
+	return fmt.Sprintf("%x:%d", txHash, nout)
+}
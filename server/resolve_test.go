@@ -0,0 +1,101 @@
+package server_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lbryio/herald/db"
+	server "github.com/lbryio/herald/server"
+)
+
+// TestResolveUrls tests that urls are resolved once per normalized url, and
+// that the results are in the same order as the urls.
+func TestResolveUrls(t *testing.T) {
+	resolveUrls := server.ResolveUrlsExported()
+	urls := []string{
+		"lbry://@Channel/stream",
+		"lbry://@channel/stream",
+		"lbry://other",
+		"lbry://@Channel/stream",
+		"lbry://other#1",
+		"lbry://Other",
+	}
+	var mut sync.Mutex
+	calls := make(map[string]int)
+	resolve := func(url string) *db.ExpandedResolveResult {
+		mut.Lock()
+		calls[url] += 1
+		mut.Unlock()
+		return db.NewExpandedResolveResult()
+	}
+	results, err := resolveUrls(context.Background(), urls, 4, resolve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != len(urls) {
+		t.Fatalf("expected %d results, got %d", len(urls), len(results))
+	}
+	if len(calls) != 3 {
+		t.Errorf("expected 3 urls to be resolved, got %v", calls)
+	}
+	for url, n := range calls {
+		if n != 1 {
+			t.Errorf("expected %s to be resolved once, got %d", url, n)
+		}
+	}
+	for _, pair := range [][2]int{{0, 1}, {0, 3}, {2, 5}} {
+		if results[pair[0]] != results[pair[1]] {
+			t.Errorf("expected urls %d and %d to share a result", pair[0], pair[1])
+		}
+	}
+	if results[2] == results[4] {
+		t.Errorf("expected urls 2 and 4 to have different results")
+	}
+}
+
+// TestResolveUrlsWorkers tests that no more than the given number of urls are
+// resolved at the same time.
+func TestResolveUrlsWorkers(t *testing.T) {
+	resolveUrls := server.ResolveUrlsExported()
+	urls := make([]string, 0, 50)
+	for i := 0; i < 50; i++ {
+		urls = append(urls, "lbry://stream"+string(rune('a'+i%26))+string(rune('a'+i/26)))
+	}
+	var running, maxRunning int32
+	resolve := func(url string) *db.ExpandedResolveResult {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return db.NewExpandedResolveResult()
+	}
+	results, err := resolveUrls(context.Background(), urls, 5, resolve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != len(urls) {
+		t.Fatalf("expected %d results, got %d", len(urls), len(results))
+	}
+	for i, res := range results {
+		if res == nil {
+			t.Errorf("missing result for url %d", i)
+		}
+	}
+	if maxRunning > 5 {
+		t.Errorf("expected at most 5 concurrent resolves, got %d", maxRunning)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := resolveUrls(ctx, urls, 5, resolve); err == nil {
+		t.Errorf("expected an error for a cancelled context")
+	}
+}
@@ -2,7 +2,6 @@ package server
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"math"
@@ -48,6 +47,9 @@ type record struct {
 	SupportAmount      uint64  `json:"support_amount"`
 	TrendingScore      float64 `json:"trending_score"`
 	ClaimName          string  `json:"claim_name"`
+
+	// index is the es index the record was found in.
+	index string
 }
 
 // orderField is struct for specifying ordering of es search results.
@@ -237,41 +239,28 @@ func (s *Server) Search(ctx context.Context, in *pb.SearchRequest) (*pb.Outputs,
 	var from = 0
 	var pageSize = 10
 	var orderBy []orderField
-	var searchIndices []string
 	var searchResult *elastic.SearchResult = nil
 	client := s.EsClient
-	searchIndices = make([]string, 0, 1)
-	searchIndices = append(searchIndices, s.Args.EsIndex)
-
-	//Code for debugging locally
-	//indices, _ := client.IndexNames()
-	//for _, index := range indices {
-	//	if index != "claims" {
-	//		log.Println(index)
-	//		searchIndices = append(searchIndices, index)
-	//	}
-	//}
-
-	// If it's been more than RefreshDelta time since we last checked if the
-	// es index has been refreshed, we check (this is 2 seconds in prod,
-	// 0 seconds in debug / unit testing). If the index has been refreshed
-	// a different number of times since we last checked, we purge the cache
-	if time.Now().After(s.LastRefreshCheck.Add(s.RefreshDelta)) {
-		res, err := client.IndexStats(searchIndices[0]).Do(ctx)
-		if err != nil {
-			log.Printf("Error on ES index stats\n%v\n", err)
-			return &pb.Outputs{}, nil
-		}
-		numRefreshes := res.Indices[searchIndices[0]].Primaries.Refresh.Total
-		if numRefreshes != s.NumESRefreshes {
-			_ = s.QueryCache.Purge()
-			s.NumESRefreshes = numRefreshes
-		}
+	searchIndices, err := s.searchIndices(in)
+	if err != nil {
+		return nil, err
 	}
 
-	var records []*record
+	serializedRequest := s.serializeSearchRequest(in)
+
+	// While es is down, answer from stale responses or the db instead.
+	if !s.EsBreaker.Allow(time.Now()) {
+		return s.degradedSearch(in, serializedRequest)
+	}
+	var esErr error
+	defer func() { s.esDone(ctx, esErr) }()
 
-	cacheKey := s.serializeSearchRequest(in)
+	// The cache is keyed on the es generation, which is bumped in the
+	// background every time the index is refreshed, so we never return
+	// results from before a refresh.
+	cacheKey := s.queryCacheKey(serializedRequest)
+
+	var records []*record
 
 	setPageVars(in, &pageSize, &from)
 
@@ -297,12 +286,16 @@ func (s *Server) Search(ctx context.Context, in *pb.SearchRequest) (*pb.Outputs,
 			return nil, err
 		}
 		q = s.setupEsQuery(q, in, &orderBy)
+		query, err := s.applyRankingProfile(q, in)
+		if err != nil {
+			return nil, err
+		}
 
 		fsc := elastic.NewFetchSourceContext(true).Exclude("description", "title")
 		search := client.Search().
 			Index(searchIndices...).
 			FetchSourceContext(fsc).
-			Query(q). // specify the query
+			Query(query). // specify the query
 			From(0).Size(DefaultSearchSize)
 
 		for _, x := range orderBy {
@@ -310,6 +303,7 @@ func (s *Server) Search(ctx context.Context, in *pb.SearchRequest) (*pb.Outputs,
 		}
 
 		searchResult, err = search.Do(ctx) // execute
+		esErr = err
 		if err != nil && elastic.IsNotFound(err) {
 			log.Println("Index returned 404! Check writer. Index: ", searchIndices)
 			return &pb.Outputs{}, nil
@@ -317,6 +311,9 @@ func (s *Server) Search(ctx context.Context, in *pb.SearchRequest) (*pb.Outputs,
 		} else if err != nil {
 			metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "search"}).Inc()
 			log.Println("Error executing query: ", err)
+			if isEsOutage(ctx, err) {
+				return s.degradedSearch(in, serializedRequest)
+			}
 			return nil, err
 		}
 
@@ -334,27 +331,38 @@ func (s *Server) Search(ctx context.Context, in *pb.SearchRequest) (*pb.Outputs,
 
 	txos, extraTxos, blocked := s.postProcessResults(ctx, client, records, in, pageSize, from, searchIndices)
 
+	var out *pb.Outputs
 	if in.NoTotals {
-		return &pb.Outputs{
+		out = &pb.Outputs{
 			Txos:      txos,
 			ExtraTxos: extraTxos,
 			Offset:    uint32(int64(from) + searchResult.TotalHits()),
 			Blocked:   blocked,
-		}, nil
+		}
+	} else {
+		var blockedTotal uint32 = 0
+		for _, b := range blocked {
+			blockedTotal += b.Count
+		}
+		out = &pb.Outputs{
+			Txos:         txos,
+			ExtraTxos:    extraTxos,
+			Total:        uint32(searchResult.TotalHits()),
+			Offset:       uint32(int64(from) + searchResult.TotalHits()),
+			Blocked:      blocked,
+			BlockedTotal: blockedTotal,
+		}
+	}
+
+	if in.IncludeClaimValues {
+		s.addClaimValues(out)
 	}
 
-	var blockedTotal uint32 = 0
-	for _, b := range blocked {
-		blockedTotal += b.Count
+	// Keep the response around to serve if es goes down.
+	if err := s.StaleCache.Set(serializedRequest, out); err != nil {
+		log.Println("Error storing response in stale cache: ", err)
 	}
-	return &pb.Outputs{
-		Txos:         txos,
-		ExtraTxos:    extraTxos,
-		Total:        uint32(searchResult.TotalHits()),
-		Offset:       uint32(int64(from) + searchResult.TotalHits()),
-		Blocked:      blocked,
-		BlockedTotal: blockedTotal,
-	}, nil
+	return out, nil
 }
 
 // normalizeTag takes a string and normalizes it for search in es.
@@ -476,7 +484,10 @@ func (s *Server) checkQuery(in *pb.SearchRequest) error {
 	}
 	for name, failed := range checks {
 		if failed {
-			time.Sleep(time.Second * 2) // throttle
+			// Throttle, unless the rate limiter is already doing that.
+			if s.RateLimiter == nil {
+				time.Sleep(time.Second * 2)
+			}
 			return fmt.Errorf("%s cant have more than %d items.", name, limit)
 		}
 	}
@@ -702,51 +713,33 @@ func (s *Server) setupEsQuery(
 func (s *Server) getUniqueChannels(records []*record, client *elastic.Client, ctx context.Context, searchIndices []string) ([]*pb.Output, map[string]*pb.Output) {
 	channels := make(map[string]*pb.Output)
 	channelsSet := make(map[string]bool)
-	var mget = client.Mget()
-	var totalChannels = 0
+	channelIds := make([]string, 0, len(records))
 	for _, r := range records {
-		for _, searchIndex := range searchIndices {
-			if r.ChannelId != "" && !channelsSet[r.ChannelId] {
-				channelsSet[r.ChannelId] = true
-				nmget := elastic.NewMultiGetItem().Id(r.ChannelId).Index(searchIndex)
-				mget = mget.Add(nmget)
-				totalChannels++
-			}
-			if r.CensorType != 0 && !channelsSet[r.CensoringChannelId] {
-				channelsSet[r.CensoringChannelId] = true
-				nmget := elastic.NewMultiGetItem().Id(r.CensoringChannelId).Index(searchIndex)
-				mget = mget.Add(nmget)
-				totalChannels++
-			}
+		if r.ChannelId != "" && !channelsSet[r.ChannelId] {
+			channelsSet[r.ChannelId] = true
+			channelIds = append(channelIds, r.ChannelId)
+		}
+		if r.CensorType != 0 && !channelsSet[r.CensoringChannelId] {
+			channelsSet[r.CensoringChannelId] = true
+			channelIds = append(channelIds, r.CensoringChannelId)
 		}
 	}
-	if totalChannels == 0 {
+	if len(channelIds) == 0 {
 		return []*pb.Output{}, make(map[string]*pb.Output)
 	}
 
-	res, err := mget.Do(ctx)
+	channelRecords, err := mgetRecords(ctx, client, channelIds, searchIndices)
 	if err != nil {
 		metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "get_unique_channels"}).Inc()
 		log.Println(err)
 		return []*pb.Output{}, make(map[string]*pb.Output)
 	}
 
-	channelTxos := make([]*pb.Output, totalChannels)
-	//repostedRecords := make([]*record, totalReposted)
-
-	//log.Println("total channel", totalChannels)
-	for i, doc := range res.Docs {
-		var r record
-		err := json.Unmarshal(doc.Source, &r)
-		if err != nil {
-			metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "json"}).Inc()
-			log.Println(err)
-			return []*pb.Output{}, make(map[string]*pb.Output)
-		}
-		channelTxos[i] = r.recordToOutput()
-		channels[r.ClaimId] = channelTxos[i]
-		//log.Println(r)
-		//repostedRecords[i] = &r
+	channelTxos := make([]*pb.Output, 0, len(channelRecords))
+	for _, r := range channelRecords {
+		txo := r.recordToOutput()
+		channelTxos = append(channelTxos, txo)
+		channels[r.ClaimId] = txo
 	}
 
 	return channelTxos, channels
@@ -756,52 +749,27 @@ func (s *Server) getUniqueChannels(records []*record, client *elastic.Client, ct
 // an array and map of the reposted records as well as an array of those
 // records.
 func (s *Server) getClaimsForReposts(ctx context.Context, client *elastic.Client, records []*record, searchIndices []string) ([]*pb.Output, []*record, map[string]*pb.Output) {
-
-	var totalReposted = 0
-	var mget = client.Mget() //.StoredFields("_id")
-	/*
-		var nmget = elastic.NewMultiGetItem()
-		for _, index := range searchIndices {
-			nmget = nmget.Index(index)
-		}
-	*/
+	repostedIds := make([]string, 0, len(records))
 	for _, r := range records {
-		for _, searchIndex := range searchIndices {
-			if r.RepostedClaimId != "" {
-				var nmget = elastic.NewMultiGetItem().Id(r.RepostedClaimId).Index(searchIndex)
-				//nmget = nmget.Id(r.RepostedClaimId)
-				mget = mget.Add(nmget)
-				totalReposted++
-			}
+		if r.RepostedClaimId != "" {
+			repostedIds = append(repostedIds, r.RepostedClaimId)
 		}
 	}
-	//mget = mget.Add(nmget)
-	if totalReposted == 0 {
+	if len(repostedIds) == 0 {
 		return []*pb.Output{}, []*record{}, make(map[string]*pb.Output)
 	}
 
-	res, err := mget.Do(ctx)
+	repostedRecords, err := mgetRecords(ctx, client, repostedIds, searchIndices)
 	if err != nil {
 		metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "mget"}).Inc()
 		log.Println(err)
 		return []*pb.Output{}, []*record{}, make(map[string]*pb.Output)
 	}
 
-	claims := make([]*pb.Output, totalReposted)
-	repostedRecords := make([]*record, totalReposted)
+	claims := make([]*pb.Output, len(repostedRecords))
 	respostedMap := make(map[string]*pb.Output)
-
-	//log.Println("reposted records", totalReposted)
-	for i, doc := range res.Docs {
-		var r record
-		err := json.Unmarshal(doc.Source, &r)
-		if err != nil {
-			metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "json"}).Inc()
-			log.Println(err)
-			return []*pb.Output{}, []*record{}, make(map[string]*pb.Output)
-		}
+	for i, r := range repostedRecords {
 		claims[i] = r.recordToOutput()
-		repostedRecords[i] = &r
 		respostedMap[r.ClaimId] = claims[i]
 	}
 
@@ -13,6 +13,7 @@ import (
 	"os"
 	"regexp"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ReneKroon/ttlcache/v2"
@@ -30,28 +31,35 @@ import (
 )
 
 type Server struct {
-	GrpcServer       *grpc.Server
-	Args             *Args
-	MultiSpaceRe     *regexp.Regexp
-	WeirdCharsRe     *regexp.Regexp
-	DB               *db.ReadOnlyDBColumnFamily
-	EsClient         *elastic.Client
-	QueryCache       *ttlcache.Cache
-	S256             *hash.Hash
-	LastRefreshCheck time.Time
-	RefreshDelta     time.Duration
-	NumESRefreshes   int64
-	PeerServers      map[string]*Peer
-	PeerServersMut   sync.RWMutex
-	NumPeerServers   *int64
-	PeerSubs         map[string]*Peer
-	PeerSubsMut      sync.RWMutex
-	NumPeerSubs      *int64
-	ExternalIP       net.IP
-	HeightSubs       map[net.Addr]net.Conn
-	HeightSubsMut    sync.RWMutex
-	NotifierChan     chan *internal.HeightHash
+	GrpcServer      *grpc.Server
+	Args            *Args
+	MultiSpaceRe    *regexp.Regexp
+	WeirdCharsRe    *regexp.Regexp
+	DB              *db.ReadOnlyDBColumnFamily
+	EsClient        *elastic.Client
+	QueryCache      *ttlcache.Cache
+	StaleCache      *ttlcache.Cache
+	EsBreaker       *CircuitBreaker
+	RankingProfiles *RankingProfiles
+	RateLimiter     *RateLimiter
+	S256            *hash.Hash
+	RefreshDelta    time.Duration
+	NumESRefreshes  int64
+	PeerServers     map[string]*Peer
+	PeerServersMut  sync.RWMutex
+	NumPeerServers  *int64
+	PeerSubs        map[string]*Peer
+	PeerSubsMut     sync.RWMutex
+	NumPeerSubs     *int64
+	ExternalIP      net.IP
+	HeightSubs      map[net.Addr]net.Conn
+	HeightSubsMut   sync.RWMutex
+	NotifierChan    chan *internal.HeightHash
 	pb.UnimplementedHubServer
+
+	esGeneration   uint64
+	esSyncHeight   uint32
+	esStatsIndices atomic.Value
 }
 
 func getVersion() string {
@@ -187,6 +195,10 @@ func LoadDatabase(args *Args) (*db.ReadOnlyDBColumnFamily, error) {
 
 	myDB.BlockingChannelHashes = blockingChannelHashes
 	myDB.FilteringChannelHashes = filteringChannelHashes
+	if args.ResolveCacheSize > 0 {
+		myDB.ResolveCache = db.NewResolveCache(args.ResolveCacheSize, time.Duration(args.ResolveCacheTTL)*time.Minute)
+	}
+	myDB.AuditSignatures = args.AuditSignatures
 	return myDB, nil
 }
 
@@ -194,7 +206,15 @@ func LoadDatabase(args *Args) (*db.ReadOnlyDBColumnFamily, error) {
 // initializes everything. It loads information about previously known peers,
 // creates needed internal data structures, and initializes goroutines.
 func MakeHubServer(ctx context.Context, args *Args) *Server {
-	grpcServer := grpc.NewServer(grpc.NumStreamWorkers(0))
+	var rateLimiter *RateLimiter = nil
+	grpcOpts := []grpc.ServerOption{grpc.NumStreamWorkers(0)}
+	if args.RateLimit > 0 {
+		rateLimiter = NewRateLimiter(args.RateLimit, args.RateLimitBurst, args.RateLimitApiKeys, args.RateLimitApiKeyRate, args.RateLimitApiKeyBurst)
+		grpcOpts = append(grpcOpts,
+			grpc.UnaryInterceptor(rateLimiter.UnaryServerInterceptor),
+			grpc.StreamInterceptor(rateLimiter.StreamServerInterceptor))
+	}
+	grpcServer := grpc.NewServer(grpcOpts...)
 
 	multiSpaceRe, err := regexp.Compile(`\s{2,}`)
 	if err != nil {
@@ -229,6 +249,18 @@ func MakeHubServer(ctx context.Context, args *Args) *Server {
 	if err != nil {
 		log.Fatal(err)
 	}
+	staleCache := ttlcache.NewCache()
+	err = staleCache.SetTTL(time.Duration(args.StaleCacheTTL) * time.Minute)
+	if err != nil {
+		log.Fatal(err)
+	}
+	staleCache.SetCacheSizeLimit(StaleCacheSize)
+	esBreaker := NewCircuitBreaker(args.EsBreakerThreshold, time.Duration(args.EsBreakerCooldown)*time.Second)
+	rankingProfiles := NewRankingProfiles(args.RankingProfilesFile)
+	if _, err := rankingProfiles.Load(); err != nil {
+		log.Fatal(err)
+	}
+
 	s256 := sha256.New()
 	var refreshDelta = time.Second * time.Duration(args.RefreshDelta)
 	if args.Debug {
@@ -251,27 +283,30 @@ func MakeHubServer(ctx context.Context, args *Args) *Server {
 	}
 
 	s := &Server{
-		GrpcServer:       grpcServer,
-		Args:             args,
-		MultiSpaceRe:     multiSpaceRe,
-		WeirdCharsRe:     weirdCharsRe,
-		DB:               myDB,
-		EsClient:         client,
-		QueryCache:       cache,
-		S256:             &s256,
-		LastRefreshCheck: time.Now(),
-		RefreshDelta:     refreshDelta,
-		NumESRefreshes:   0,
-		PeerServers:      make(map[string]*Peer),
-		PeerServersMut:   sync.RWMutex{},
-		NumPeerServers:   numPeers,
-		PeerSubs:         make(map[string]*Peer),
-		PeerSubsMut:      sync.RWMutex{},
-		NumPeerSubs:      numSubs,
-		ExternalIP:       net.IPv4(127, 0, 0, 1),
-		HeightSubs:       make(map[net.Addr]net.Conn),
-		HeightSubsMut:    sync.RWMutex{},
-		NotifierChan:     make(chan *internal.HeightHash),
+		GrpcServer:      grpcServer,
+		Args:            args,
+		MultiSpaceRe:    multiSpaceRe,
+		WeirdCharsRe:    weirdCharsRe,
+		DB:              myDB,
+		EsClient:        client,
+		QueryCache:      cache,
+		StaleCache:      staleCache,
+		EsBreaker:       esBreaker,
+		RankingProfiles: rankingProfiles,
+		RateLimiter:     rateLimiter,
+		S256:            &s256,
+		RefreshDelta:    refreshDelta,
+		NumESRefreshes:  0,
+		PeerServers:     make(map[string]*Peer),
+		PeerServersMut:  sync.RWMutex{},
+		NumPeerServers:  numPeers,
+		PeerSubs:        make(map[string]*Peer),
+		PeerSubsMut:     sync.RWMutex{},
+		NumPeerSubs:     numSubs,
+		ExternalIP:      net.IPv4(127, 0, 0, 1),
+		HeightSubs:      make(map[net.Addr]net.Conn),
+		HeightSubsMut:   sync.RWMutex{},
+		NotifierChan:    make(chan *internal.HeightHash),
 	}
 
 	// Start up our background services
@@ -282,6 +317,15 @@ func MakeHubServer(ctx context.Context, args *Args) *Server {
 	if !args.DisableBlockingAndFiltering {
 		myDB.RunGetBlocksAndFilters()
 	}
+	if !args.DisableEs {
+		s.RunEsRefreshWatcher(ctx)
+	}
+	if args.RankingProfilesFile != "" {
+		s.RunReloadRankingProfiles()
+	}
+	if rateLimiter != nil {
+		rateLimiter.RunPruneRateLimits()
+	}
 	if !args.DisableStartPrometheus {
 		go s.prometheusEndpoint(s.Args.PrometheusPort, "metrics")
 	}
@@ -321,9 +365,10 @@ func MakeHubServer(ctx context.Context, args *Args) *Server {
 }
 
 // prometheusEndpoint is a goroutine which start up a prometheus endpoint
-// for this hub to allow for metric tracking.
+// for this hub to allow for metric tracking, along with a health endpoint.
 func (s *Server) prometheusEndpoint(port string, endpoint string) {
 	http.Handle("/"+endpoint, promhttp.Handler())
+	http.HandleFunc("/health", s.healthHandler)
 	log.Println(fmt.Sprintf("listening on :%s /%s", port, endpoint))
 	err := http.ListenAndServe(":"+port, nil)
 	log.Fatalln("Shouldn't happen??!?!", err)
@@ -445,34 +490,3 @@ func (s *Server) HeightHashSubscribe() error {
 
 	return nil
 }
-
-func (s *Server) Resolve(ctx context.Context, args *pb.StringArray) (*pb.Outputs, error) {
-	metrics.RequestsCount.With(prometheus.Labels{"method": "resolve"}).Inc()
-
-	allTxos := make([]*pb.Output, 0)
-	allExtraTxos := make([]*pb.Output, 0)
-
-	for _, url := range args.Value {
-		res := s.DB.Resolve(url)
-		txos, extraTxos, err := res.ToOutputs()
-		if err != nil {
-			return nil, err
-		}
-		// TODO: there may be a more efficient way to do this.
-		allTxos = append(allTxos, txos...)
-		allExtraTxos = append(allExtraTxos, extraTxos...)
-	}
-
-	res := &pb.Outputs{
-		Txos:         allTxos,
-		ExtraTxos:    allExtraTxos,
-		Total:        uint32(len(allTxos) + len(allExtraTxos)),
-		Offset:       0,   //TODO
-		Blocked:      nil, //TODO
-		BlockedTotal: 0,   //TODO
-	}
-
-	logrus.Warn(res)
-
-	return res, nil
-}
@@ -1,5 +1,13 @@
 package server
 
+import (
+	"context"
+
+	"github.com/lbryio/herald/db"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/olivere/elastic/v7"
+)
+
 func (s *Server) AddPeerExported() func(*Peer, bool, bool) error {
 	return s.addPeer
 }
@@ -7,3 +15,35 @@ func (s *Server) AddPeerExported() func(*Peer, bool, bool) error {
 func (s *Server) GetNumPeersExported() func() int64 {
 	return s.getNumPeers
 }
+
+func (s *Server) ExpandCollectionExported() func(*pb.Output, uint32, uint32) ([]*db.ExpandedResolveResult, []*pb.Output, error) {
+	return s.expandCollection
+}
+
+func IsEsOutageExported() func(context.Context, error) bool {
+	return isEsOutage
+}
+
+func (s *Server) SearchIndicesExported() func(*pb.SearchRequest) ([]string, error) {
+	return s.searchIndices
+}
+
+func MgetRecordsExported() func(context.Context, *elastic.Client, []string, []string) ([]*record, error) {
+	return mgetRecords
+}
+
+func (r *record) IndexExported() string {
+	return r.index
+}
+
+func ResolveUrlsExported() func(context.Context, []string, int, func(string) *db.ExpandedResolveResult) ([]*db.ExpandedResolveResult, error) {
+	return resolveUrls
+}
+
+func (s *Server) ChannelClaimsExported() func(context.Context, *pb.ChannelClaimsRequest, int) (*pb.Outputs, error) {
+	return s.channelClaims
+}
+
+func (s *Server) ListRepostsExported() func(context.Context, *pb.ListRepostsRequest, int) (*pb.Outputs, error) {
+	return s.listReposts
+}
@@ -0,0 +1,54 @@
+package server
+
+// signatures.go contains the endpoint verifying the channel signature of a
+// claim.
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// VerifyClaimSignature is a grpc endpoint that verifies the channel signature
+// of a claim against the channel's current public key, and returns it along
+// with the flag stored by the writer.
+func (s *Server) VerifyClaimSignature(ctx context.Context, in *pb.VerifyClaimSignatureRequest) (*pb.VerifyClaimSignatureResponse, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "verify_claim_signature"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "verify_claim_signature"}).
+			Observe(delta)
+	}(time.Now())
+
+	claimHash, err := parseClaimId(in.ClaimId)
+	if err != nil {
+		return nil, err
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "signature verification is unavailable")
+	}
+	check, err := s.DB.CheckClaimSignature(claimHash)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "can't verify the signature of claim %s: %v", in.ClaimId, err)
+	} else if check == nil {
+		return nil, status.Errorf(codes.NotFound, "claim %s not found", in.ClaimId)
+	}
+
+	res := &pb.VerifyClaimSignatureResponse{
+		Valid:       check.Valid,
+		StoredValid: check.StoredValid,
+	}
+	if check.ChannelHash != nil {
+		res.Signed = true
+		res.ChannelId = hex.EncodeToString(check.ChannelHash)
+	}
+	return res, nil
+}
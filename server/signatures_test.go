@@ -0,0 +1,47 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestVerifyClaimSignature tests verifying claim signatures. In the test
+// data channel cc signs the claims in txs 2 and 3, the signature in tx 3 is
+// bad but stored as valid, and the claim in tx 4 isn't signed.
+func TestVerifyClaimSignature(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/E_signatures.csv")
+
+	tests := []struct {
+		claimId string
+		want    *pb.VerifyClaimSignatureResponse
+	}{
+		{"0202020202020202020202020202020202020202", &pb.VerifyClaimSignatureResponse{Signed: true, ChannelId: "cccccccccccccccccccccccccccccccccccccccc", Valid: true, StoredValid: true}},
+		{"0303030303030303030303030303030303030303", &pb.VerifyClaimSignatureResponse{Signed: true, ChannelId: "cccccccccccccccccccccccccccccccccccccccc", Valid: false, StoredValid: true}},
+		{"0404040404040404040404040404040404040404", &pb.VerifyClaimSignatureResponse{}},
+	}
+	for _, tt := range tests {
+		res, err := hubServer.VerifyClaimSignature(ctx, &pb.VerifyClaimSignatureRequest{ClaimId: tt.claimId})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Signed != tt.want.Signed || res.ChannelId != tt.want.ChannelId || res.Valid != tt.want.Valid || res.StoredValid != tt.want.StoredValid {
+			t.Errorf("%s: expected %v, got %v", tt.claimId, tt.want, res)
+		}
+	}
+
+	_, err := hubServer.VerifyClaimSignature(ctx, &pb.VerifyClaimSignatureRequest{ClaimId: "0606060606060606060606060606060606060606"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+	_, err = hubServer.VerifyClaimSignature(ctx, &pb.VerifyClaimSignatureRequest{ClaimId: "06"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+package server
+
+// suggest.go contains the typeahead suggest endpoint, which completes claim
+// names, channel names and tags for a prefix.
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lbryio/herald/internal"
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/olivere/elastic/v7"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// DefaultSuggestLimit is the number of suggestions of each kind returned
+	// when the request doesn't set a limit.
+	DefaultSuggestLimit = 10
+	// MaxSuggestLimit is the most suggestions of each kind we'll return.
+	MaxSuggestLimit = 50
+)
+
+// luceneReserved are the characters that have to be escaped in an es regexp.
+const luceneReserved = `.?+*|{}[]()"\#@&<>~`
+
+// Suggest is a grpc endpoint that returns claim name, channel name and tag
+// completions for a prefix, ordered by effective amount. Blocked and filtered
+// claims are never suggested. If es is disabled the names are looked up in
+// the database instead and no tags are returned.
+func (s *Server) Suggest(ctx context.Context, in *pb.SuggestRequest) (*pb.SuggestResponse, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "suggest"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "suggest"}).
+			Observe(delta)
+	}(time.Now())
+
+	prefix := internal.NormalizeName(strings.TrimSpace(in.Prefix))
+	if prefix == "" {
+		return nil, fmt.Errorf("prefix can't be empty")
+	}
+	limit := DefaultSuggestLimit
+	if in.Limit > 0 {
+		limit = int(in.Limit)
+	}
+	if limit > MaxSuggestLimit {
+		limit = MaxSuggestLimit
+	}
+
+	if s.Args.DisableEs {
+		if s.DB == nil {
+			return &pb.SuggestResponse{}, nil
+		}
+		return s.suggestFromDB(prefix, limit)
+	}
+
+	suggestions, err := s.suggestNames(ctx, prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	if in.IncludeTags {
+		tags, err := s.suggestTags(ctx, s.normalizeTag(in.Prefix), limit)
+		if err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, tags...)
+	}
+
+	return &pb.SuggestResponse{Suggestions: suggestions}, nil
+}
+
+// suggestFromDB suggests claim and channel names with a prefix scan of the
+// controlling claims in the database.
+func (s *Server) suggestFromDB(prefix string, limit int) (*pb.SuggestResponse, error) {
+	names, err := s.DB.SuggestNames(prefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]*pb.Suggestion, 0, len(names))
+	for _, name := range names {
+		suggestionType := pb.Suggestion_CLAIM
+		if name.IsChannel() {
+			suggestionType = pb.Suggestion_CHANNEL
+		}
+		suggestions = append(suggestions, &pb.Suggestion{
+			Type:            suggestionType,
+			Text:            name.NormalizedName,
+			ClaimId:         hex.EncodeToString(name.ClaimHash),
+			EffectiveAmount: name.EffectiveAmount,
+		})
+	}
+	return &pb.SuggestResponse{Suggestions: suggestions}, nil
+}
+
+// suggestNames suggests the controlling claims of names starting with prefix.
+func (s *Server) suggestNames(ctx context.Context, prefix string, limit int) ([]*pb.Suggestion, error) {
+	q := SuggestNamesQuery(prefix)
+	fsc := elastic.NewFetchSourceContext(true).Include("claim_id", "claim_name", "effective_amount", "censor_type")
+	searchResult, err := s.EsClient.Search().
+		Index(s.defaultIndices()...).
+		FetchSourceContext(fsc).
+		Query(q).
+		Sort("effective_amount", false).
+		From(0).Size(limit).
+		Do(ctx)
+	if err != nil && elastic.IsNotFound(err) {
+		log.Println("Index returned 404! Check writer. Index: ", s.defaultIndices())
+		return nil, nil
+	} else if err != nil {
+		metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "suggest"}).Inc()
+		log.Println("Error executing suggest query: ", err)
+		return nil, err
+	}
+
+	records := s.searchResultToRecords(searchResult)
+	suggestions := make([]*pb.Suggestion, 0, len(records))
+	for _, r := range records {
+		suggestionType := pb.Suggestion_CLAIM
+		if strings.HasPrefix(r.ClaimName, "@") {
+			suggestionType = pb.Suggestion_CHANNEL
+		}
+		suggestions = append(suggestions, &pb.Suggestion{
+			Type:            suggestionType,
+			Text:            r.ClaimName,
+			ClaimId:         r.ClaimId,
+			EffectiveAmount: r.EffectiveAmount,
+		})
+	}
+	return suggestions, nil
+}
+
+// suggestTags suggests tags starting with prefix, ranked by the total
+// effective amount of the claims using them.
+func (s *Server) suggestTags(ctx context.Context, prefix string, limit int) ([]*pb.Suggestion, error) {
+	if prefix == "" {
+		return nil, nil
+	}
+	q, agg := SuggestTagsQuery(prefix, limit)
+	searchResult, err := s.EsClient.Search().
+		Index(s.defaultIndices()...).
+		Query(q).
+		Aggregation("tags", agg).
+		Size(0).
+		Do(ctx)
+	if err != nil && elastic.IsNotFound(err) {
+		log.Println("Index returned 404! Check writer. Index: ", s.defaultIndices())
+		return nil, nil
+	} else if err != nil {
+		metrics.ErrorsCounter.With(prometheus.Labels{"error_type": "suggest"}).Inc()
+		log.Println("Error executing suggest tags query: ", err)
+		return nil, err
+	}
+
+	terms, ok := searchResult.Aggregations.Terms("tags")
+	if !ok {
+		return nil, nil
+	}
+	suggestions := make([]*pb.Suggestion, 0, len(terms.Buckets))
+	for _, bucket := range terms.Buckets {
+		tag, ok := bucket.Key.(string)
+		if !ok {
+			continue
+		}
+		var amount uint64 = 0
+		if sum, ok := bucket.Sum("effective_amount"); ok && sum.Value != nil {
+			amount = uint64(*sum.Value)
+		}
+		suggestions = append(suggestions, &pb.Suggestion{
+			Type:            pb.Suggestion_TAG,
+			Text:            tag,
+			EffectiveAmount: amount,
+		})
+	}
+	return suggestions, nil
+}
+
+// SuggestNamesQuery returns the es query for the controlling, uncensored
+// claims and channels whose normalized name starts with prefix.
+func SuggestNamesQuery(prefix string) *elastic.BoolQuery {
+	return elastic.NewBoolQuery().
+		Must(elastic.NewPrefixQuery("normalized_name.keyword", prefix)).
+		Must(elastic.NewTermQuery("is_controlling", true)).
+		Must(elastic.NewTermQuery("censor_type", 0)).
+		MustNot(elastic.NewTermQuery("claim_type", 3)) // reposts
+}
+
+// SuggestTagsQuery returns the es query and aggregation for the tags starting
+// with prefix on uncensored claims.
+func SuggestTagsQuery(prefix string, limit int) (*elastic.BoolQuery, *elastic.TermsAggregation) {
+	q := elastic.NewBoolQuery().
+		Must(elastic.NewPrefixQuery("tags.keyword", prefix)).
+		Must(elastic.NewTermQuery("censor_type", 0))
+	agg := elastic.NewTermsAggregation().
+		Field("tags.keyword").
+		Include(escapeLuceneRegexp(prefix)+".*").
+		Size(limit).
+		SubAggregation("effective_amount", elastic.NewSumAggregation().Field("effective_amount")).
+		OrderByAggregation("effective_amount", false)
+	return q, agg
+}
+
+// escapeLuceneRegexp escapes s so it matches literally in an es regexp.
+func escapeLuceneRegexp(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if strings.ContainsRune(luceneReserved, c) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
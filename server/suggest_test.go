@@ -0,0 +1,51 @@
+package server_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	server "github.com/lbryio/herald/server"
+)
+
+// TestSuggestQueries tests the es queries used for suggestions.
+func TestSuggestQueries(t *testing.T) {
+	src, err := server.SuggestNamesQuery("cat").Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(data)
+	for _, want := range []string{
+		`{"prefix":{"normalized_name.keyword":"cat"}}`,
+		`{"term":{"is_controlling":true}}`,
+		`{"term":{"censor_type":0}}`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %s in %s", want, got)
+		}
+	}
+
+	_, agg := server.SuggestTagsQuery("c++", 5)
+	src, err = agg.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err = json.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = string(data)
+	for _, want := range []string{
+		`"include":"c\\+\\+.*"`,
+		`"size":5`,
+		`"order":[{"effective_amount":"desc"}]`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %s in %s", want, got)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package server
+
+// supports.go contains the endpoints listing the supports of a claim and
+// looking up the claim a support is for.
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/lbryio/herald/db"
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultSupportsLimit is the number of supports returned if the request
+	// doesn't set a limit, and MaxSupportsLimit the most.
+	DefaultSupportsLimit = 50
+	MaxSupportsLimit     = 200
+)
+
+// ListSupports is a grpc endpoint that lists a page of the supports for a
+// claim, by height or amount.
+func (s *Server) ListSupports(ctx context.Context, in *pb.ListSupportsRequest) (*pb.ListSupportsResponse, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "list_supports"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "list_supports"}).
+			Observe(delta)
+	}(time.Now())
+
+	claimHash, err := parseClaimId(in.ClaimId)
+	if err != nil {
+		return nil, err
+	}
+	order, descending, err := parseSupportOrder(in.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "supports are unavailable")
+	}
+
+	limit := in.Limit
+	if limit == 0 {
+		limit = DefaultSupportsLimit
+	} else if limit > MaxSupportsLimit {
+		limit = MaxSupportsLimit
+	}
+	supports, total, err := s.DB.GetClaimSupports(claimHash, order, descending, int(in.Offset), int(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ListSupportsResponse{
+		Supports: make([]*pb.Support, 0, len(supports)),
+		Total:    uint32(total),
+	}
+	for _, support := range supports {
+		txHash, err := s.DB.GetTxHash(support.TxNum)
+		if err != nil {
+			return nil, err
+		}
+		res.Supports = append(res.Supports, &pb.Support{
+			TxHash:           txHash,
+			Nout:             uint32(support.Position),
+			Height:           support.Height,
+			Amount:           support.Amount,
+			ActivationHeight: support.ActivationHeight,
+		})
+	}
+	return res, nil
+}
+
+// SupportClaim is a grpc endpoint that returns the claim a support is for.
+func (s *Server) SupportClaim(ctx context.Context, in *pb.SupportClaimRequest) (*pb.SupportClaimResponse, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "support_claim"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "support_claim"}).
+			Observe(delta)
+	}(time.Now())
+
+	if len(in.TxHash) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %x", in.TxHash)
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "supports are unavailable")
+	}
+
+	txNum, err := s.DB.GetTxNum(in.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	var claimHash []byte
+	if txNum != nil && in.Nout <= 0xffff {
+		claimHash, err = s.DB.GetSupportClaim(txNum.TxNum, uint16(in.Nout))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if claimHash == nil {
+		return nil, status.Errorf(codes.NotFound, "no support at %x:%d", in.TxHash, in.Nout)
+	}
+	return &pb.SupportClaimResponse{ClaimId: hex.EncodeToString(claimHash)}, nil
+}
+
+// parseSupportOrder parses the order_by of a ListSupports request, which
+// sorts newest first by default like search does.
+func parseSupportOrder(orderBy string) (db.SupportOrder, bool, error) {
+	descending := !strings.HasPrefix(orderBy, "^")
+	switch strings.TrimPrefix(orderBy, "^") {
+	case "", "height":
+		return db.SupportsByHeight, descending, nil
+	case "amount":
+		return db.SupportsByAmount, descending, nil
+	}
+	return 0, false, status.Errorf(codes.InvalidArgument, "invalid order_by %q", orderBy)
+}
@@ -0,0 +1,90 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestListSupports tests paging and sorting the supports of a claim. The
+// test data has supports in txs 4, 6 and 9 for 30, 100 and 50, and the tx
+// hashes start with the tx num.
+func TestListSupports(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/K_supports.csv")
+	claimId := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+	tests := []struct {
+		name   string
+		req    *pb.ListSupportsRequest
+		txNums []byte
+	}{
+		{"newest first", &pb.ListSupportsRequest{ClaimId: claimId}, []byte{9, 6, 4}},
+		{"oldest first", &pb.ListSupportsRequest{ClaimId: claimId, OrderBy: "^height"}, []byte{4, 6, 9}},
+		{"largest first", &pb.ListSupportsRequest{ClaimId: claimId, OrderBy: "amount"}, []byte{6, 9, 4}},
+		{"page", &pb.ListSupportsRequest{ClaimId: claimId, OrderBy: "^amount", Offset: 1, Limit: 1}, []byte{9}},
+		{"past the end", &pb.ListSupportsRequest{ClaimId: claimId, Offset: 3}, []byte{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := hubServer.ListSupports(ctx, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Total != 3 {
+				t.Errorf("expected a total of 3, got %d", res.Total)
+			}
+			if len(res.Supports) != len(tt.txNums) {
+				t.Fatalf("expected %d supports, got %v", len(tt.txNums), res.Supports)
+			}
+			for i, txNum := range tt.txNums {
+				if res.Supports[i].TxHash[0] != txNum {
+					t.Errorf("expected support %d in tx %d, got %v", i, txNum, res.Supports[i])
+				}
+			}
+		})
+	}
+
+	_, err := hubServer.ListSupports(ctx, &pb.ListSupportsRequest{ClaimId: claimId, OrderBy: "name"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}
+
+// TestSupportClaim tests looking up the claim a support is for.
+func TestSupportClaim(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/K_supports.csv")
+
+	txHash := make([]byte, 32)
+	txHash[0] = 6
+	res, err := hubServer.SupportClaim(ctx, &pb.SupportClaimRequest{TxHash: txHash, Nout: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ClaimId != "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" {
+		t.Errorf("expected claim aa..., got %s", res.ClaimId)
+	}
+
+	tests := []struct {
+		name string
+		req  *pb.SupportClaimRequest
+		code codes.Code
+	}{
+		{"short hash", &pb.SupportClaimRequest{TxHash: txHash[:4]}, codes.InvalidArgument},
+		{"no support at nout", &pb.SupportClaimRequest{TxHash: txHash, Nout: 1}, codes.NotFound},
+		{"unknown tx", &pb.SupportClaimRequest{TxHash: make([]byte, 32)}, codes.NotFound},
+	}
+	for _, tt := range tests {
+		_, err := hubServer.SupportClaim(ctx, tt.req)
+		if status.Code(err) != tt.code {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.code, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package server
+
+// takeovers.go contains the endpoints predicting when control of names will
+// change.
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/lbryio/herald/db"
+	"github.com/lbryio/herald/internal"
+	"github.com/lbryio/herald/internal/metrics"
+	pb "github.com/lbryio/herald/protobuf/go"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultTakeoverBlocks is the number of blocks UpcomingTakeovers looks
+// ahead if the request doesn't set it. Bids activate at most
+// db.MaxTakeoverDelay blocks ahead, so it doesn't look further.
+const DefaultTakeoverBlocks = 576
+
+// PredictTakeover is a grpc endpoint that predicts when control of a name
+// will change and which claim will win it, from the bids pending
+// activation.
+func (s *Server) PredictTakeover(ctx context.Context, in *pb.PredictTakeoverRequest) (*pb.TakeoverPrediction, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "predict_takeover"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "predict_takeover"}).
+			Observe(delta)
+	}(time.Now())
+
+	normalizedName := internal.NormalizeName(in.Name)
+	if normalizedName == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "takeover predictions are unavailable")
+	}
+
+	prediction, err := s.DB.PredictTakeover(normalizedName)
+	if err != nil {
+		return nil, err
+	}
+	return takeoverPredictionToProto(prediction), nil
+}
+
+// UpcomingTakeovers is a grpc endpoint that lists the takeovers of names
+// expected in the next blocks, by height.
+func (s *Server) UpcomingTakeovers(ctx context.Context, in *pb.UpcomingTakeoversRequest) (*pb.UpcomingTakeoversResponse, error) {
+	metrics.RequestsCount.With(prometheus.Labels{"method": "upcoming_takeovers"}).Inc()
+	defer func(t time.Time) {
+		delta := time.Since(t).Seconds()
+		metrics.
+			QueryTime.
+			With(prometheus.Labels{"method": "upcoming_takeovers"}).
+			Observe(delta)
+	}(time.Now())
+
+	if s.DB == nil {
+		return nil, status.Error(codes.Unavailable, "takeover predictions are unavailable")
+	}
+	blocks := in.Blocks
+	if blocks == 0 {
+		blocks = DefaultTakeoverBlocks
+	} else if blocks > db.MaxTakeoverDelay {
+		blocks = db.MaxTakeoverDelay
+	}
+
+	predictions, err := s.DB.PredictTakeovers(s.DB.Height + blocks)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.UpcomingTakeoversResponse{
+		Takeovers: make([]*pb.TakeoverPrediction, 0, len(predictions)),
+	}
+	for _, prediction := range predictions {
+		res.Takeovers = append(res.Takeovers, takeoverPredictionToProto(prediction))
+	}
+	return res, nil
+}
+
+// takeoverPredictionToProto converts a takeover prediction to its protobuf
+// message.
+func takeoverPredictionToProto(prediction *db.TakeoverPrediction) *pb.TakeoverPrediction {
+	res := &pb.TakeoverPrediction{
+		NormalizedName:       prediction.NormalizedName,
+		Height:               prediction.Height,
+		EffectiveAmount:      prediction.EffectiveAmount,
+		NextActivationHeight: prediction.NextActivationHeight,
+	}
+	if prediction.Controlling != nil {
+		res.ControllingClaimId = hex.EncodeToString(prediction.Controlling.ClaimHash)
+		res.TakeoverHeight = prediction.Controlling.Height
+	}
+	if prediction.ClaimHash != nil {
+		res.ClaimId = hex.EncodeToString(prediction.ClaimHash)
+	}
+	return res
+}
@@ -0,0 +1,60 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/lbryio/herald/protobuf/go"
+	server "github.com/lbryio/herald/server"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestPredictTakeover tests predicting the takeover of a name, and listing
+// the upcoming takeovers. In the test data cc... takes over test from
+// aa... at height 12.
+func TestPredictTakeover(t *testing.T) {
+	ctx := context.Background()
+	hubServer := server.MakeHubServer(ctx, makeDefaultArgs())
+	hubServer.DB = openTestDB(t, "../testdata/Q_name_bids.csv")
+	hubServer.DB.Height = 10
+
+	res, err := hubServer.PredictTakeover(ctx, &pb.PredictTakeoverRequest{Name: "Test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ControllingClaimId != "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" || res.TakeoverHeight != 4 {
+		t.Errorf("expected aa... to control test since 4, got %v", res)
+	}
+	if res.ClaimId != "cccccccccccccccccccccccccccccccccccccccc" || res.Height != 12 {
+		t.Errorf("expected cc... to take over at 12, got %v", res)
+	}
+
+	res, err = hubServer.PredictTakeover(ctx, &pb.PredictTakeoverRequest{Name: "tie"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ClaimId != "" || res.Height != 0 {
+		t.Errorf("expected no takeover, got %v", res)
+	}
+
+	upcoming, err := hubServer.UpcomingTakeovers(ctx, &pb.UpcomingTakeoversRequest{Blocks: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(upcoming.Takeovers) != 0 {
+		t.Errorf("expected no takeovers in the next block, got %v", upcoming.Takeovers)
+	}
+	upcoming, err = hubServer.UpcomingTakeovers(ctx, &pb.UpcomingTakeoversRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(upcoming.Takeovers) != 2 || upcoming.Takeovers[1].ClaimId != "cccccccccccccccccccccccccccccccccccccccc" {
+		t.Errorf("expected 2 takeovers, got %v", upcoming.Takeovers)
+	}
+
+	_, err = hubServer.PredictTakeover(ctx, &pb.PredictTakeoverRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument, got %v", err)
+	}
+}